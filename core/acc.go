@@ -42,6 +42,7 @@ type Acc struct {
 	cronWam       *gocron.Scheduler
 	cronDaily     *gocron.Scheduler
 	cronMediaConn *gocron.Scheduler
+	cronEphemeral *gocron.Scheduler
 
 	Log *db.Logger
 }
@@ -77,6 +78,9 @@ func NewAcc(acc_id uint64) (*Acc, error) {
 
 	ev.On(def.Ev_Log, NewLogHook(a))
 
+	// push `identity_changed`
+	store.OnIdentityChange = a.on_identity_change
+
 	ev.On(def.Ev_AccCreate, New_Hook_AccCreate(a))
 
 	// receive call
@@ -93,6 +97,10 @@ func NewAcc(acc_id uint64) (*Acc, error) {
 	// decode message
 	ev.On(def.Ev_message, New_Hook_Msg(a))
 	ev.On(def.Ev_message, New_Hook_GroupMsg(a))
+	// delivered/read of sent messages, including broadcast lists
+	ev.On(def.Ev_receipt, New_Hook_MsgStatus(a))
+	// peer fails to decrypt our message
+	ev.On(def.Ev_receipt, New_Hook_RetryReceipt(a))
 	// receipt ack
 	ev.On(def.Ev_receipt, New_Hook_Receipt(a))
 	// dirty (group/account_sync)
@@ -111,7 +119,9 @@ func NewAcc(acc_id uint64) (*Acc, error) {
 	ev.On(def.Ev_notification, New_Hook_GroupCreate(a))
 	ev.On(def.Ev_notification, New_Hook_GroupAdd(a))
 	ev.On(def.Ev_notification, New_Hook_GroupLeave(a))
-	// Peer SetEncrypt,clear session/identity
+	ev.On(def.Ev_notification, New_Hook_GroupAdminChange(a))
+	ev.On(def.Ev_notification, New_Hook_GroupEphemeral(a))
+	// Peer SetEncrypt,clear session, mark identity changed
 	ev.On(def.Ev_notification, New_Hook_PeerIdentityChange(a))
 	// server ask for more prekeys
 	ev.On(def.Ev_notification, New_Hook_NeedMorePrekeys(a))
@@ -311,7 +321,7 @@ func (c Core) AccDump(j *ajson.Json) *ajson.Json {
 
 	dir := fmt.Sprintf("acc_dump_%d", id)
 
-	tables := []string{"Profile", "Device", "Config", "Schedule", "Session", "Prekey", "Identity", "SignedPrekey", "SenderKey", "Proxy", "Message", "Group", "GroupMember", "WamSchedule", "Cdn"}
+	tables := []string{"Profile", "Device", "Config", "Schedule", "Session", "Prekey", "Identity", "IdentityHistory", "SignedPrekey", "SenderKey", "SenderKeyDistribution", "Proxy", "Message", "SentMessage", "MsgRecipient", "Poll", "PollVote", "ChatEphemeral", "StatusUpdate", "Group", "GroupMember", "BroadcastList", "WamSchedule", "Cdn"}
 
 	defer func() {
 		afs.RemoveDir(dir)
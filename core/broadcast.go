@@ -0,0 +1,237 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"ajson"
+	"wa/def"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func is_broadcast_list(jid string) bool {
+	return strings.HasSuffix(jid, `@broadcast`) && jid != StatusBroadcast
+}
+
+func broadcast_list_json(bl *def.BroadcastList) *ajson.Json {
+	j := ajson.New()
+	j.Set(`list_id`, bl.ListId)
+	j.Set(`name`, bl.Name)
+	j.Set(`recipients`, bl.Recipients)
+	return j
+}
+
+/*
+params:
+
+	name: name of the list
+	recipients: ["111@s.whatsapp.net", ...]
+
+result:
+
+	{list_id: "1600000000000@broadcast"}
+*/
+func (c Core) CreateBroadcastList(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	name, e := j.Get(`name`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'name'`))
+	}
+	recipients, e := j.Get(`recipients`).TryStringArray()
+	if e != nil || len(recipients) == 0 {
+		return NewErrRet(errors.New(`missing 'recipients'`))
+	}
+
+	bl := &def.BroadcastList{
+		ListId:     fmt.Sprintf("%d@broadcast", time.Now().UnixMilli()),
+		Name:       name,
+		Recipients: recipients,
+	}
+	if e := a.Store.CreateBroadcastList(bl); e != nil {
+		return NewErrRet(e)
+	}
+
+	ret := NewSucc()
+	ret.Set(`list_id`, bl.ListId)
+	return ret
+}
+
+/*
+params:
+
+	list_id: the broadcast list
+	name: optional, new name
+	recipients: optional, replaces all recipients
+*/
+func (c Core) UpdateBroadcastList(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	list_id, e := j.Get(`list_id`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'list_id'`))
+	}
+
+	mod := bson.M{}
+	if name, e := j.Get(`name`).TryString(); e == nil {
+		mod[`Name`] = name
+	}
+	if recipients, e := j.Get(`recipients`).TryStringArray(); e == nil {
+		if len(recipients) == 0 {
+			return NewErrRet(errors.New(`empty 'recipients'`))
+		}
+		mod[`Recipients`] = recipients
+	}
+	if len(mod) == 0 {
+		return NewErrRet(errors.New(`nothing to update`))
+	}
+	if e := a.Store.ModifyBroadcastList(list_id, mod); e != nil {
+		return NewErrRet(errors.Wrap(e, `fail update broadcast list`))
+	}
+	return NewSucc()
+}
+
+/*
+params:
+
+	list_id: the broadcast list
+*/
+func (c Core) DeleteBroadcastList(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	list_id, e := j.Get(`list_id`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'list_id'`))
+	}
+	if e := a.Store.DeleteBroadcastList(list_id); e != nil {
+		return NewErrRet(errors.Wrap(e, `fail delete broadcast list`))
+	}
+	return NewSucc()
+}
+
+/*
+result:
+
+	{lists: [{list_id, name, recipients}, ...]}
+*/
+func (c Core) ListBroadcastLists(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	bls, e := a.Store.ListBroadcastLists()
+	if e != nil {
+		return NewErrRet(e)
+	}
+	ret_arr := []*ajson.Json{}
+	for _, bl := range bls {
+		ret_arr = append(ret_arr, broadcast_list_json(bl))
+	}
+	r := NewSucc()
+	r.Set(`lists`, ret_arr)
+	return r
+}
+
+/*
+params:
+
+	list_id: the broadcast list
+	media_type, media: same as SendMsg
+*/
+func (c Core) SendBroadcast(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	list_id, e := j.Get(`list_id`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'list_id'`))
+	}
+	bl, e := a.Store.GetBroadcastList(list_id)
+	if e != nil {
+		return NewErrRet(errors.Wrap(e, `broadcast list not found`))
+	}
+
+	j.Set(`gid`, bl.ListId)
+	j.Set(`participants`, bl.Recipients)
+	return c.SendGroupMsg(j)
+}
+
+/*
+receipts of a broadcast message, per recipient,
+a recipient is delivered/read if any of its devices is
+
+params:
+
+	msg_id: the broadcast message
+
+result:
+
+	{
+		total, delivered, read,
+		recipients: [{jid, status, t}, ...]  // status: sent/delivered/read/played
+	}
+*/
+func (c Core) GetBroadcastReceipts(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	msg_id, e := j.Get(`msg_id`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'msg_id'`))
+	}
+	rs, e := a.Store.ListMsgRecipients(msg_id)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	if len(rs) == 0 || !is_broadcast_list(rs[0].Chat) {
+		return NewErrRet(errors.New(`no broadcast record of msg ` + msg_id))
+	}
+
+	// highest status of each recipient's devices
+	users := []string{}
+	best := map[string]*def.MsgRecipient{}
+	for _, r := range rs {
+		jid := clear_jid_device(r.Jid)
+		b, ok := best[jid]
+		if !ok {
+			users = append(users, jid)
+		}
+		if !ok || r.Status > b.Status {
+			best[jid] = r
+		}
+	}
+
+	r := NewSucc()
+	delivered, read := 0, 0
+	ret_arr := []*ajson.Json{}
+	for _, jid := range users {
+		b := best[jid]
+		if b.Status >= MsgStatus_Delivered {
+			delivered++
+		}
+		if b.Status >= MsgStatus_Read {
+			read++
+		}
+		x := ajson.New()
+		x.Set(`jid`, jid)
+		x.Set(`status`, msg_status_str(b.Status))
+		x.Set(`t`, b.T)
+		ret_arr = append(ret_arr, x)
+	}
+	r.Set(`total`, len(users))
+	r.Set(`delivered`, delivered)
+	r.Set(`read`, read)
+	r.Set(`recipients`, ret_arr)
+	return r
+}
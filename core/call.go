@@ -1,44 +1,36 @@
 package core
 
 import (
+	"wa/stanza"
 	"wa/xmpp"
 )
 
 func New_Hook_CallOffer(a *Acc) func(...any) error {
-	// Decrypt and Replace node.Data
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		from, _ := n.GetAttr(`from`)
-		id, _ := n.GetAttr(`id`)
-
-		if len(n.Children) == 0 {
+		c, ok := args[0].(*stanza.Call)
+		if !ok {
 			return nil
 		}
-		ch0 := n.Children[0]
-		if ch0.Tag != `offer` {
+
+		if c.Action != `offer` {
 			return nil
 		}
-
-		ch0a := ch0.MapAttrs()
-		call_creator, ok1 := ch0a[`call-creator`]
-		call_id, ok2 := ch0a[`call-id`]
-		if !ok1 || !ok2 {
+		if c.CallCreator == `` || c.CallId == `` {
 			return nil
 		}
 
 		a.Noise.WriteXmppNode(&xmpp.Node{
 			Tag: `receipt`,
 			Attrs: []*xmpp.KeyValue{
-				{Key: `id`, Value: id},
-				{Key: `to`, Value: from},
+				{Key: `id`, Value: c.Id},
+				{Key: `to`, Value: c.From},
 			},
 			Children: []*xmpp.Node{
 				{
-					Tag: ch0.Tag,
+					Tag: c.Action,
 					Attrs: []*xmpp.KeyValue{
-						{Key: `call-creator`, Value: call_creator},
-						{Key: `call-id`, Value: call_id},
+						{Key: `call-creator`, Value: c.CallCreator},
+						{Key: `call-id`, Value: c.CallId},
 					},
 				},
 			},
@@ -49,19 +41,13 @@ func New_Hook_CallOffer(a *Acc) func(...any) error {
 }
 
 func New_Hook_CallAck(a *Acc) func(...any) error {
-	// Decrypt and Replace node.Data
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		from, _ := n.GetAttr(`from`)
-		id, _ := n.GetAttr(`id`)
-
-		if len(n.Children) == 0 {
+		c, ok := args[0].(*stanza.Call)
+		if !ok {
 			return nil
 		}
-		ch0 := n.Children[0]
 
-		switch ch0.Tag {
+		switch c.Action {
 		case `relaylatency`:
 		case `terminate`:
 		default:
@@ -72,9 +58,9 @@ func New_Hook_CallAck(a *Acc) func(...any) error {
 			Tag: `ack`,
 			Attrs: []*xmpp.KeyValue{
 				{Key: `class`, Value: `call`},
-				{Key: `to`, Value: from},
-				{Key: `id`, Value: id},
-				{Key: `type`, Value: ch0.Tag},
+				{Key: `to`, Value: c.From},
+				{Key: `id`, Value: c.Id},
+				{Key: `type`, Value: c.Action},
 			},
 		})
 
@@ -1,10 +1,16 @@
 package core
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"io"
 	"math/big"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"ajson"
@@ -54,20 +60,23 @@ func cdn_http_header(host, ua string) fhttp.Header {
 	return m
 }
 
-func (a *Acc) cdn_download(
+// GET the encrypted file with http header `Range` if not empty,
+// returned body must be closed by caller
+func (a *Acc) cdn_get(
 	media Media,
-) ([]byte, error) {
+	range_ string,
+) (int, io.ReadCloser, error) {
 	dev, e := a.Store.GetDev()
 	if e != nil {
-		return nil, e
+		return 0, nil, e
 	}
 	proxy, dns, e := a.Store.GetProxy()
 	if e != nil {
-		return nil, e
+		return 0, nil, e
 	}
 	cdn, e := a.Store.GetCdn()
 	if e != nil {
-		return nil, e
+		return 0, nil, e
 	}
 
 	ua := net.UA(dev.IsBusiness, def.VERSION(dev.IsBusiness), dev.AndroidVersion, dev.Brand, dev.Model)
@@ -83,7 +92,7 @@ func (a *Acc) cdn_download(
 
 		url_, err := url.Parse(media.MsgUrl())
 		if err != nil {
-			return nil, err
+			return 0, nil, err
 		}
 		host = url_.Hostname()
 	}
@@ -102,22 +111,79 @@ func (a *Acc) cdn_download(
 	a.Log.Debug("host: " + `https://` + host)
 	a.Log.Debug("url: " + url_)
 
-	_, body, e := net.HttpGet(
+	hdr := cdn_http_header(host, ua)
+	if range_ != `` {
+		hdr.Set(`Range`, range_)
+		hdr[fhttp.HeaderOrderKey] = append(hdr[fhttp.HeaderOrderKey], `range`)
+	}
+
+	return net.HttpGetStream(
 		host,
 		url_,
 		proxy, dns, dev.Ja3Config,
-		cdn_http_header(host, ua),
+		hdr,
 	)
+}
+
+// download the encrypted file from `offset` to the end,
+// returned body must be closed by caller
+func (a *Acc) cdn_download(
+	media Media,
+	offset int64,
+) (io.ReadCloser, error) {
+	range_ := ``
+	if offset > 0 {
+		range_ = fmt.Sprintf(`bytes=%d-`, offset)
+	}
+	status, body, e := a.cdn_get(media, range_)
 	if e != nil {
 		return nil, e
 	}
+	if status != 200 && status != 206 {
+		body.Close()
+		return nil, errors.New(`cdn download status: ` + strconv.Itoa(status))
+	}
+	if offset > 0 && status != 206 {
+		body.Close()
+		return nil, errors.New(`cdn doesn't support range download`)
+	}
 
 	return body, nil
 }
 
+// if the file is gone from cdn, only the first byte is requested
+func (a *Acc) cdn_expired(media Media) (bool, error) {
+	status, body, e := a.cdn_get(media, `bytes=0-0`)
+	if e != nil {
+		return false, e
+	}
+	body.Close()
+
+	switch status {
+	case 200, 206:
+		return false, nil
+	case 404, 410:
+		return true, nil
+	}
+	return false, errors.New(`cdn status: ` + strconv.Itoa(status))
+}
+
+// download and decrypt the whole file to `w`
+func (a *Acc) download_media(media Media, w io.Writer) error {
+	body, e := a.cdn_download(media, 0)
+	if e != nil {
+		return e
+	}
+	defer body.Close()
+
+	return decryptMediaStream(media, body, w)
+}
+
 func (a *Acc) cdn_upload(
 	media Media,
-	f_enc []byte,
+	f_enc io.Reader,
+	enc_len int64,
+	encFileHash []byte,
 ) (string, string, error) {
 	dev, e := a.Store.GetDev()
 	if e != nil {
@@ -151,8 +217,6 @@ func (a *Acc) cdn_upload(
 			host = "mmg.whatsapp.net"
 		}
 	}
-	encFileHash := algo.Sha256(f_enc)
-
 	token := algo.Sha256(
 		append(cdn.UploadTokenRandomBytes, encFileHash...),
 	)
@@ -169,12 +233,12 @@ func (a *Acc) cdn_upload(
 	a.Log.Debug("url: " + url_)
 
 	ua := net.UA(dev.IsBusiness, def.VERSION(dev.IsBusiness), dev.AndroidVersion, dev.Brand, dev.Model)
-	_, body, e := net.HttpPost(
+	_, body, e := net.HttpPostStream(
 		host,
 		url_,
 		proxy, dns, dev.Ja3Config,
 		cdn_http_header(host, ua),
-		f_enc,
+		f_enc, enc_len,
 	)
 	if e != nil {
 		return ``, ``, e
@@ -287,6 +351,60 @@ func (a *Acc) set_media_conn() error {
 	return nil
 }
 
+// `path` param of media rpc, relative to def.MediaDir,
+// absolute path is accepted if it's inside def.MediaDir
+func media_path(path string) (string, error) {
+	base, e := filepath.Abs(def.MediaDir)
+	if e != nil {
+		return ``, e
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(base, path)
+	}
+	path = filepath.Clean(path)
+
+	rel, e := filepath.Rel(base, path)
+	if e != nil || rel == `.` || rel == `..` ||
+		strings.HasPrefix(rel, `..`+string(filepath.Separator)) {
+		return ``, errors.New(`'path' must be inside the media dir`)
+	}
+	return path, nil
+}
+
+// write to a temp file in the same dir, renamed to `path` when succeeded,
+// so no broken file left if failed
+func write_file_atomic(path string, fn func(w io.Writer) error) error {
+	f, e := os.CreateTemp(filepath.Dir(path), `.media-*`)
+	if e != nil {
+		return e
+	}
+	defer os.Remove(f.Name())
+
+	if e := fn(f); e != nil {
+		f.Close()
+		return e
+	}
+	if e := f.Close(); e != nil {
+		return e
+	}
+	return os.Rename(f.Name(), path)
+}
+
+/*
+	{
+		media_type: "video",
+		media: {...},
+
+		// optional, save to file instead of returning base64,
+		// relative to the media dir
+		path: "a.mp4",
+
+		// optional, decrypt range [from, to) with sidecar,
+		// for video/ptt only, `to` omitted means to the end
+		from: 0,
+		to: 65536,
+	}
+*/
 func (c Core) DownloadMedia(j *ajson.Json) *ajson.Json {
 	a, e := GetAccFromJson(j)
 	if e != nil {
@@ -303,86 +421,193 @@ func (c Core) DownloadMedia(j *ajson.Json) *ajson.Json {
 		return NewErrRet(e)
 	}
 	mj := j.Get(`media`)
-	if media.FillFromJson(mj) != nil {
+	if e := media.FillFromJson(mj); e != nil {
 		return NewErrRet(errors.Wrap(e, `fail parse media json`))
 	}
 
-	enc, e := a.cdn_download(media)
-	if e != nil {
-		return NewErrRet(e)
+	download := func(w io.Writer) error {
+		return a.download_media(media, w)
 	}
+	if j.Exists(`from`) || j.Exists(`to`) {
+		from := j.Get(`from`).Int64()
+		to := j.Get(`to`).Int64()
 
-	dec, e := decryptMedia(media, enc)
-	if e != nil {
+		download = func(w io.Writer) error {
+			return decryptMediaRange(
+				media, from, to,
+				func(offset int64) (io.ReadCloser, error) {
+					return a.cdn_download(media, offset)
+				},
+				w,
+			)
+		}
+	}
+
+	// to file
+	if path, e := j.Get(`path`).TryString(); e == nil && len(path) > 0 {
+		path, e := media_path(path)
+		if e != nil {
+			return NewErrRet(e)
+		}
+		if e := write_file_atomic(path, download); e != nil {
+			return NewErrRet(e)
+		}
+		ret := NewSucc()
+		ret.Set(`path`, path)
+		return ret
+	}
+
+	var dec bytes.Buffer
+	if e := download(&dec); e != nil {
 		return NewErrRet(e)
 	}
 
 	ret := NewSucc()
-	ret.Set(`media`, algo.B64Enc(dec))
+	ret.Set(`media`, algo.B64Enc(dec.Bytes()))
 	return ret
 }
 
+// the media json contains the file instead of uploaded cdn info
+func is_raw_media(media Media, mj *ajson.Json) bool {
+	if media.MsgCategory() != `media` || len(mj.Get(`directPath`).String()) > 0 {
+		return false
+	}
+	return len(mj.Get(`path`).String()) > 0 ||
+		len(mj.Get(MediaTypeStr(media.Type())).String()) > 0
+}
+
+/*
+	{
+		media_type: "video",
+
+		// file content, either
+		video: "base64...",
+		// or file in the media dir
+		path: "a.mp4",
+
+		...other fields of the media,
+		for image/video, mimeType/width/height/thumbnail/mediaDuration
+		are derived from the file if missing
+	}
+*/
 func (c Core) UploadMedia(j *ajson.Json) *ajson.Json {
 	a, e := GetAccFromJson(j)
 	if e != nil {
 		return NewErrRet(e)
 	}
 
-	// 1. create media
 	media_type, e := j.Get(`media_type`).TryString()
 	if e != nil {
 		return NewErrRet(errors.New(`invalid media_type`))
 	}
-	media, e := NewMedia(MediaTypeInt(media_type))
+
+	media, e := a.upload_media(MediaTypeInt(media_type), j)
 	if e != nil {
 		return NewErrRet(e)
 	}
 
-	// 2. encrypt media binary, fill proto fields
-	var f_enc []byte
+	ret := NewSucc()
+	ret.Set(`media`, media.ToJson().Data())
+	return ret
+}
+
+func (a *Acc) upload_media(media_t pb.Media_Type, j *ajson.Json) (Media, error) {
+	media_type := MediaTypeStr(media_t)
+
+	// 1. create media
+	media, e := NewMedia(media_t)
+	if e != nil {
+		return nil, e
+	}
+
+	// 2. encrypt media binary to temp file, fill proto fields
+	f_enc, e := os.CreateTemp(``, `media-enc-*`)
+	if e != nil {
+		return nil, e
+	}
+	defer os.Remove(f_enc.Name())
+	defer f_enc.Close()
+
+	var enc *media_enc_result
 	{
-		// 1. get file binary
-		raw, err := j.Get(media_type).TryString()
-		if err != nil {
-			return NewErrRet(errors.New(`missing '` + media_type + `' attribute`))
+		// 1. get file reader
+		var f io.ReadSeeker
+		if path, err := j.Get(`path`).TryString(); err == nil && len(path) > 0 {
+			path, err := media_path(path)
+			if err != nil {
+				return nil, err
+			}
+			file, err := os.Open(path)
+			if err != nil {
+				return nil, err
+			}
+			defer file.Close()
+			f = file
+		} else {
+			raw, err := j.Get(media_type).TryString()
+			if err != nil {
+				return nil, errors.New(`missing '` + media_type + `' attribute`)
+			}
+			bs, err := algo.B64Dec(raw)
+			if err != nil {
+				return nil, errors.New(`'` + media_type + `' not base64`)
+			}
+			f = bytes.NewReader(bs)
 		}
-		f, err := algo.B64Dec(raw)
-		if err != nil {
-			return NewErrRet(errors.New(`'` + media_type + `' not base64`))
+		// not fatal, caller may provide them
+		if err := fill_media_meta(media_t, f, j); err != nil {
+			a.Log.Debug(`fail parse media meta: ` + err.Error())
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return nil, err
 		}
 		// 2. fill media random mediaKey
 		mediaKey := arand.Bytes(0x20)
 		mediaKeyTimestamp := time.Now().Unix()
 
 		// 3. encrypt
-		f_enc, err = encryptMedia(mediaKey, media.SaltString(), f)
-		if err != nil {
-			return NewErrRet(errors.New(`fail encrypt content`))
+		buf := bufio.NewWriter(f_enc)
+		enc, e = encryptMediaStream(
+			mediaKey, media.SaltString(),
+			has_sidecar(media.Type()),
+			f, buf,
+		)
+		if e != nil {
+			return nil, errors.Wrap(e, `fail encrypt content`)
+		}
+		if e := buf.Flush(); e != nil {
+			return nil, e
 		}
-		j.Set(`encFileHash`, algo.B64Enc(algo.Sha256(f_enc)))
-		j.Set(`fileHash`, algo.B64Enc(algo.Sha256(f)))
+		j.Set(`encFileHash`, algo.B64Enc(enc.EncFileHash))
+		j.Set(`fileHash`, algo.B64Enc(enc.FileHash))
+		j.Set(`fileLength`, enc.FileLength)
 		j.Set(`mediaKey`, algo.B64Enc(mediaKey))
 		j.Set(`mediaKeyTimestamp`, mediaKeyTimestamp)
+		if len(enc.Sidecar) > 0 {
+			j.Set(`sidecar`, algo.B64Enc(enc.Sidecar))
+		}
 	}
 
 	// 3. fill media from json
-	if media.FillFromJson(j) != nil {
-		return NewErrRet(errors.Wrap(e, `fail parse media json`))
+	if e := media.FillFromJson(j); e != nil {
+		return nil, errors.Wrap(e, `fail parse media json`)
 	}
 
 	// 4. upload
-	msg_url, dir_path, e := a.cdn_upload(media, f_enc)
+	if _, e := f_enc.Seek(0, io.SeekStart); e != nil {
+		return nil, e
+	}
+	msg_url, dir_path, e := a.cdn_upload(
+		media, bufio.NewReader(f_enc), enc.EncLength, enc.EncFileHash)
 	if e != nil {
-		return NewErrRet(e)
+		return nil, e
 	}
 	// fill messageUrl/directPath
 	j.Set(`messageUrl`, msg_url)
 	j.Set(`directPath`, dir_path)
-	if media.FillFromJson(j) != nil {
-		return NewErrRet(errors.Wrap(e, `fail parse media json`))
+	if e := media.FillFromJson(j); e != nil {
+		return nil, errors.Wrap(e, `fail parse media json`)
 	}
 
-	ret := NewSucc()
-	ret.Set(`media`, media.ToJson().Data())
-	return ret
+	return media, nil
 }
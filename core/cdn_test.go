@@ -0,0 +1,24 @@
+package core
+
+import (
+	"path/filepath"
+	"testing"
+
+	"wa/def"
+)
+
+func TestMediaPath(t *testing.T) {
+	defer func(dir string) { def.MediaDir = dir }(def.MediaDir)
+	def.MediaDir = t.TempDir()
+
+	for _, p := range []string{`a.mp4`, `x/a.mp4`, `x/../a.mp4`, filepath.Join(def.MediaDir, `a.mp4`)} {
+		if _, e := media_path(p); e != nil {
+			t.Errorf("%s: %v", p, e)
+		}
+	}
+	for _, p := range []string{``, `.`, `..`, `../a.mp4`, `x/../../a.mp4`, `/etc/passwd`, def.MediaDir + `x/a.mp4`} {
+		if x, e := media_path(p); e == nil {
+			t.Errorf("%s: accepted as %s", p, x)
+		}
+	}
+}
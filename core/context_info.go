@@ -0,0 +1,190 @@
+package core
+
+import (
+	"ajson"
+	"wa/pb"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+/*
+optional params of SendMsg/SendGroupMsg:
+
+	quoted: {
+		id: message id being replied to
+		sender: jid of its sender
+		text: snippet shown in the reply bubble
+	}
+	mentions: ["111@s.whatsapp.net", ...]
+
+returns nil if neither exists
+*/
+func context_info_from_json(j *ajson.Json) (*pb.ContextInfo, error) {
+	var ci *pb.ContextInfo
+
+	if qj, ok := j.TryGet(`quoted`); ok {
+		id, e := qj.Get(`id`).TryString()
+		if e != nil {
+			return nil, errors.New(`missing 'quoted.id'`)
+		}
+		sender, e := qj.Get(`sender`).TryString()
+		if e != nil {
+			return nil, errors.New(`missing 'quoted.sender'`)
+		}
+		ci = &pb.ContextInfo{
+			StanzaId:    proto.String(id),
+			Participant: proto.String(sender),
+			QuotedMessage: &pb.Message{
+				Text: []byte(qj.Get(`text`).String()),
+			},
+		}
+	}
+	if mentions, e := j.Get(`mentions`).TryStringArray(); e == nil && len(mentions) > 0 {
+		if ci == nil {
+			ci = &pb.ContextInfo{}
+		}
+		ci.MentionedJid = mentions
+	}
+	if score, e := j.Get(`forwarding_score`).TryInt(); e == nil && score > 0 {
+		if ci == nil {
+			ci = &pb.ContextInfo{}
+		}
+		ci.IsForwarded = proto.Bool(true)
+		ci.ForwardingScore = proto.Uint32(uint32(score))
+	}
+	return ci, nil
+}
+
+// attach `ci` to whichever content the message has,
+// plain text can't carry it, it's sent as extended text (Url),
+// returns false if the content has no ContextInfo, eg: reaction
+func set_context_info(m *pb.Message, ci *pb.ContextInfo) bool {
+	if ci == nil {
+		return false
+	}
+	switch {
+	case m.Text != nil:
+		m.Url = &pb.Url{
+			Data:        proto.String(string(m.Text)),
+			ContextInfo: ci,
+		}
+		m.Text = nil
+	case m.Url != nil:
+		m.Url.ContextInfo = ci
+	case m.Image != nil:
+		m.Image.ContextInfo = ci
+	case m.Video != nil:
+		m.Video.ContextInfo = ci
+	case m.Ptt != nil:
+		m.Ptt.ContextInfo = ci
+	case m.Document != nil:
+		m.Document.ContextInfo = ci
+	case m.Sticker != nil:
+		m.Sticker.ContextInfo = ci
+	case m.Contact != nil:
+		m.Contact.ContextInfo = ci
+	case m.ContactArray != nil:
+		m.ContactArray.ContextInfo = ci
+	case m.Location != nil:
+		m.Location.ContextInfo = ci
+	case m.LiveLocation != nil:
+		m.LiveLocation.ContextInfo = ci
+	case m.PollCreation != nil:
+		m.PollCreation.ContextInfo = ci
+	case m.Buttons != nil:
+		m.Buttons.ContextInfo = ci
+	case m.List != nil:
+		m.List.ContextInfo = ci
+	case m.Template != nil:
+		m.Template.ContextInfo = ci
+	default:
+		return false
+	}
+	return true
+}
+
+// the ContextInfo of whichever content the message has, nil if not exists
+func message_context_info(m *pb.Message) *pb.ContextInfo {
+	switch {
+	case m.Url != nil:
+		return m.Url.ContextInfo
+	case m.Image != nil:
+		return m.Image.ContextInfo
+	case m.Video != nil:
+		return m.Video.ContextInfo
+	case m.Ptt != nil:
+		return m.Ptt.ContextInfo
+	case m.Document != nil:
+		return m.Document.ContextInfo
+	case m.Sticker != nil:
+		return m.Sticker.ContextInfo
+	case m.Contact != nil:
+		return m.Contact.ContextInfo
+	case m.ContactArray != nil:
+		return m.ContactArray.ContextInfo
+	case m.Location != nil:
+		return m.Location.ContextInfo
+	case m.LiveLocation != nil:
+		return m.LiveLocation.ContextInfo
+	case poll_creation(m) != nil:
+		return poll_creation(m).ContextInfo
+	case m.Buttons != nil:
+		return m.Buttons.ContextInfo
+	case m.ButtonsResponse != nil:
+		return m.ButtonsResponse.ContextInfo
+	case m.List != nil:
+		return m.List.ContextInfo
+	case m.ListResponse != nil:
+		return m.ListResponse.ContextInfo
+	case m.Template != nil:
+		return m.Template.ContextInfo
+	case m.TemplateButtonReply != nil:
+		return m.TemplateButtonReply.ContextInfo
+	case ephemeral_inner(m) != nil:
+		return message_context_info(ephemeral_inner(m))
+	case view_once_inner(m) != nil:
+		return message_context_info(view_once_inner(m))
+	}
+	return nil
+}
+
+func context_info_json(ci *pb.ContextInfo) *ajson.Json {
+	j := ajson.New()
+	if ci.StanzaId != nil {
+		j.Set(`stanzaId`, ci.GetStanzaId())
+	}
+	if ci.Participant != nil {
+		j.Set(`participant`, ci.GetParticipant())
+	}
+	if ci.RemoteJid != nil {
+		j.Set(`remoteJid`, ci.GetRemoteJid())
+	}
+	if ci.QuotedMessage != nil {
+		quoted := (&MessageContent{P: ci.QuotedMessage}).GetMedia()
+
+		qj := ajson.New()
+		qj.Set(`media_type`, MediaTypeStr(quoted.Type()))
+		qj.Set(`media`, quoted.ToJson().Data())
+		j.Set(`quotedMessage`, qj.Data())
+	}
+	if len(ci.MentionedJid) > 0 {
+		j.Set(`mentionedJid`, ci.GetMentionedJid())
+	}
+	if ci.GetIsForwarded() {
+		j.Set(`isForwarded`, true)
+		j.Set(`forwardingScore`, ci.GetForwardingScore())
+	}
+	if ci.Expiration != nil {
+		j.Set(`expiration`, ci.GetExpiration())
+	}
+	return j
+}
+
+// add `contextInfo` to the media json if exists
+func set_context_info_json(j *ajson.Json, ci *pb.ContextInfo) {
+	if ci == nil {
+		return
+	}
+	j.Set(`contextInfo`, context_info_json(ci).Data())
+}
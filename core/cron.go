@@ -435,6 +435,53 @@ func (a *Acc) StopMediaConnCron() {
 
 }
 
+// purge expired disappearing messages, status updates
+// and kept plaintexts of sent messages
+func (a *Acc) StartEphemeralCron() {
+	if a.cronEphemeral == nil {
+		a.Wg.Add(1)
+		a.cronEphemeral = gocron.NewScheduler(time.UTC)
+
+		a.cronEphemeral.Every(10).Minutes().Do(func() {
+			n, e := a.Store.DeleteExpiredMessages()
+			if e != nil {
+				a.Log.Error("fail ephemeral cron: " + e.Error())
+				return
+			}
+			if n > 0 {
+				a.Log.Debug("%d expired messages purged", n)
+			}
+			n, e = a.Store.DeleteStatusUpdatesBefore(
+				time.Now().Add(-StatusLifetime).Unix())
+			if e != nil {
+				a.Log.Error("fail ephemeral cron: " + e.Error())
+				return
+			}
+			if n > 0 {
+				a.Log.Debug("%d expired status updates purged", n)
+			}
+			n, e = a.Store.DeleteSentMessagesBefore(
+				time.Now().Add(-SentMessageKeep))
+			if e != nil {
+				a.Log.Error("fail ephemeral cron: " + e.Error())
+				return
+			}
+			if n > 0 {
+				a.Log.Debug("%d sent plaintexts purged", n)
+			}
+		})
+		a.cronEphemeral.StartAsync()
+	}
+}
+func (a *Acc) StopEphemeralCron() {
+	if a.cronEphemeral != nil {
+		a.cronEphemeral.Stop()
+		a.cronEphemeral.Clear()
+		a.cronEphemeral = nil
+		a.Wg.Done()
+	}
+}
+
 // stop all cron
 func New_Hook_StopCron(a *Acc) func(...any) error {
 	return func(...any) error {
@@ -442,6 +489,7 @@ func New_Hook_StopCron(a *Acc) func(...any) error {
 		a.StopWamCron()
 		a.StopDailyCron()
 		a.StopMediaConnCron()
+		a.StopEphemeralCron()
 		return nil
 	}
 }
@@ -0,0 +1,80 @@
+package core
+
+import (
+	"strconv"
+
+	"wa/crypto"
+	"wa/pb"
+	"wa/xmpp"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// own companion devices(eg: WhatsApp Web) linked to this account,
+// the phone itself is not included
+func (a *Acc) own_device_jids() ([]string, error) {
+	dev, e := a.Store.GetDev()
+	if e != nil {
+		return nil, e
+	}
+	recid, e := strconv.ParseUint(dev.Cc+dev.Phone, 10, 64)
+	if e != nil {
+		return nil, e
+	}
+	devids, e := a.Store.GetMultiDevice(recid)
+	if e != nil {
+		return nil, e
+	}
+	ret := []string{}
+	for _, devid := range devids {
+		if devid == 0 {
+			continue
+		}
+		ret = append(ret, build_jid(recid, devid))
+	}
+	return ret, nil
+}
+
+// `jid` attr of all <to> nodes
+func to_node_jids(nodes []*xmpp.Node) []string {
+	ret := []string{}
+	for _, n := range nodes {
+		if jid, ok := n.GetAttr(`jid`); ok {
+			ret = append(ret, jid)
+		}
+	}
+	return ret
+}
+
+/*
+Wrap the message with destination jid, so own companion devices
+can show it as an outgoing message:
+
+	Message {
+		deviceSentMessage {
+			destinationJid: "222@s.whatsapp.net"
+			message: {...}
+		}
+	}
+*/
+func build_device_sent_nodes(
+	a *Acc, own_jids []string,
+	dest_jid string,
+	pmsg *pb.Message,
+	media_t pb.Media_Type,
+	media Media,
+) ([]*xmpp.Node, error) {
+	dsm := &pb.Message{
+		DeviceSentMessage: &pb.DeviceSentMessage{
+			DestinationJid: proto.String(dest_jid),
+			Message:        pmsg,
+		},
+	}
+	p, e := proto.Marshal(dsm)
+	if e != nil {
+		return nil, e
+	}
+	padded := crypto.RandomPadMsg(p)
+
+	return build_to_nodes(a, own_jids, padded, media_t, media)
+}
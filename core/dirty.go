@@ -1,6 +1,7 @@
 package core
 
 import (
+	"wa/stanza"
 	"wa/xmpp"
 )
 
@@ -27,17 +28,18 @@ func (a *Acc) set_dirty_clean(type_ string) error {
 }
 func New_Hook_Dirty(a *Acc) func(...any) error {
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
+		ib, ok := args[0].(*stanza.Ib)
+		if !ok {
+			return nil
+		}
 
 		// if contains child `dirty`
-		if ch, ok := n.FindChildByTag(`dirty`); ok {
-			type_, _ := ch.GetAttr(`type`)
-
-			if type_ == `groups` {
+		if ib.Dirty != nil {
+			if ib.Dirty.Type == `groups` {
 				a.Log.Warning(`groups dirty`)
 				return a.Store.SetGroupsDirty()
 			}
-			if type_ == `account_sync` {
+			if ib.Dirty.Type == `account_sync` {
 
 				a.Log.Warning(`account_sync dirty`)
 				return a.Store.SetAccountSyncDirty()
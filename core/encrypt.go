@@ -7,6 +7,7 @@ import (
 	"ajson"
 	"wa/crypto"
 	"wa/signal/protocol"
+	"wa/stanza"
 	"wa/xmpp"
 
 	"github.com/pkg/errors"
@@ -26,6 +27,38 @@ type EncryptResult struct {
 	PrekeyId uint32
 }
 
+// parse <registration>, <identity>, <skey> and <key>
+func (er *EncryptResult) parse_keys(nodes []*xmpp.Node) {
+	for _, u_ch := range nodes {
+		switch u_ch.Tag {
+		case `registration`:
+			er.RegId = crypto.BE2U32(u_ch.Data)
+		case `identity`:
+			er.Identity = u_ch.Data
+		case `skey`:
+			for _, jj := range u_ch.Children {
+				switch jj.Tag {
+				case `id`:
+					er.SpkId = crypto.BE2U24(jj.Data)
+				case `value`:
+					er.Spk = jj.Data
+				case `signature`:
+					er.SpkSig = jj.Data
+				}
+			}
+		case `key`:
+			for _, jj := range u_ch.Children {
+				switch jj.Tag {
+				case `id`:
+					er.PrekeyId = crypto.BE2U24(jj.Data)
+				case `value`:
+					er.Prekey = jj.Data
+				}
+			}
+		}
+	}
+}
+
 /*
 	wa returns
         "code": "406", "text": "not-acceptable"
@@ -78,35 +111,7 @@ func (a *Acc) get_encrypt(
 		for _, user := range ch.Children {
 			jid, _ := user.GetAttr(`jid`)
 			er := EncryptResult{Jid: jid}
-
-			for _, u_ch := range user.Children {
-				switch u_ch.Tag {
-				case `registration`:
-					er.RegId = crypto.BE2U32(u_ch.Data)
-				case `identity`:
-					er.Identity = u_ch.Data
-				case `skey`:
-					for _, jj := range u_ch.Children {
-						switch jj.Tag {
-						case `id`:
-							er.SpkId = crypto.BE2U24(jj.Data)
-						case `value`:
-							er.Spk = jj.Data
-						case `signature`:
-							er.SpkSig = jj.Data
-						}
-					}
-				case `key`:
-					for _, jj := range u_ch.Children {
-						switch jj.Tag {
-						case `id`:
-							er.PrekeyId = crypto.BE2U24(jj.Data)
-						case `value`:
-							er.Prekey = jj.Data
-						}
-					}
-				}
-			}
+			er.parse_keys(user.Children)
 
 			ret[jid] = er
 		}
@@ -183,22 +188,16 @@ func (a *Acc) set_encrypt() error {
 // not enough prekeys on server, need to upload more
 func New_Hook_NeedMorePrekeys(a *Acc) func(...any) error {
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		attrs := n.MapAttrs()
-
-		type_, _ := attrs[`type`]
-		if type_ != `encrypt` {
+		nt, ok := args[0].(*stanza.Notification)
+		if !ok || nt.Encrypt == nil {
 			return nil
 		}
 
-		from, _ := attrs[`from`]
-		if from != `s.whatsapp.net` {
+		if nt.From != `s.whatsapp.net` {
 			return nil
 		}
 
-		_, ok := n.FindChildByTag(`count`)
-		if !ok {
+		if !nt.Encrypt.HasCount {
 			return nil
 		}
 
@@ -207,34 +206,25 @@ func New_Hook_NeedMorePrekeys(a *Acc) func(...any) error {
 }
 
 // someone re-registered, identity change
-// clear identity/session of the jid
+// mark identity changed, clear session of the jid,
+// the new key is handled by IdentityTrust policy
 func New_Hook_PeerIdentityChange(a *Acc) func(...any) error {
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		attrs := n.MapAttrs()
-
-		type_, _ := attrs[`type`]
-		if type_ != `encrypt` {
-			return nil
-		}
-
-		from, ok := attrs[`from`]
-		if !ok {
+		nt, ok := args[0].(*stanza.Notification)
+		if !ok || nt.Encrypt == nil {
 			return nil
 		}
 
-		_, ok = n.FindChildByTag(`identity`)
-		if !ok {
+		if !nt.Encrypt.IdentityChange {
 			return nil
 		}
-		recid, devid, e := split_jid(from)
+		recid, devid, e := split_jid(nt.From)
 		if e != nil {
 			return e
 		}
 		addr := protocol.NewSignalAddress(fmt.Sprintf("%d", recid), devid)
 
-		a.Store.DeleteIdentity(addr)
+		a.Store.SetIdentityChanged(addr)
 		a.Store.DeleteSession(addr)
 		a.Store.DeleteSenderKey(addr)
 
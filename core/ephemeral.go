@@ -0,0 +1,181 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"ajson"
+	"event"
+	"wa/pb"
+	"wa/stanza"
+	"wa/xmpp"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// the wrapped message of a disappearing message, nil if not wrapped
+func ephemeral_inner(m *pb.Message) *pb.Message {
+	if m.EphemeralMessage != nil {
+		return m.EphemeralMessage.GetMessage()
+	}
+	return nil
+}
+
+// stamp the chat's disappearing timer on outgoing message
+func (a *Acc) stamp_ephemeral(m *pb.Message, chat string) {
+	ce, e := a.Store.GetChatEphemeral(clear_jid_device(chat))
+	if e != nil {
+		a.Log.Error("fail get ephemeral of %s: %s", chat, e.Error())
+		return
+	}
+	if ce.Expiration == 0 {
+		return
+	}
+	ci := message_context_info(m)
+	if ci == nil {
+		ci = &pb.ContextInfo{}
+		if !set_context_info(m, ci) {
+			return
+		}
+	}
+	ci.Expiration = proto.Uint32(ce.Expiration)
+	ci.EphemeralSettingTimestamp = proto.Int64(ce.SettingTimestamp)
+}
+
+// received disappearing message, purged by the ephemeral cron
+func (a *Acc) set_message_expiration(msg_id string, t int64, content *MessageContent) {
+	// also found in the inner content of `ephemeralMessage`
+	exp := message_context_info(content.P).GetExpiration()
+	if exp == 0 {
+		return
+	}
+	expire_at := time.Unix(t, 0).Add(time.Duration(exp) * time.Second)
+	if e := a.Store.SetMessageExpireAt(msg_id, expire_at); e != nil {
+		a.Log.Error("fail set expiration of msg %s: %s", msg_id, e.Error())
+	}
+}
+
+// save the timer and push `ephemeral_setting`
+func (a *Acc) on_ephemeral_setting(
+	chat, sender string, expiration uint32, setting_ts, t int64,
+) error {
+	chat = clear_jid_device(chat)
+	if e := a.Store.SetChatEphemeral(chat, expiration, setting_ts); e != nil {
+		a.Log.Error("fail save ephemeral of %s: %s", chat, e.Error())
+	}
+
+	j := ajson.New()
+	j.Set(`chat`, chat)
+	j.Set(`sender`, sender)
+	j.Set(`expiration`, expiration)
+	j.Set(`t`, t)
+	a.push(`ephemeral_setting`, j)
+
+	return event.Stop
+}
+
+// group timer changed by admin
+func New_Hook_GroupEphemeral(a *Acc) func(...any) error {
+	return func(args ...any) error {
+		nt, ok := args[0].(*stanza.Notification)
+		if !ok || nt.Group == nil {
+			return nil
+		}
+		var expiration uint32
+		switch nt.Group.Action {
+		case `ephemeral`:
+			expiration = uint32(nt.Group.Ephemeral)
+		case `not_ephemeral`:
+		default:
+			return nil
+		}
+		a.on_ephemeral_setting(nt.From, nt.Participant, expiration, nt.T, nt.T)
+		return nil // the notification still needs ack
+	}
+}
+
+func (a *Acc) set_group_ephemeral(gid string, expiration uint32) (*xmpp.Node, error) {
+	ch := &xmpp.Node{Tag: `not_ephemeral`}
+	if expiration > 0 {
+		ch = &xmpp.Node{
+			Tag: `ephemeral`,
+			Attrs: []*xmpp.KeyValue{
+				{Key: `expiration`, Value: strconv.Itoa(int(expiration))},
+			},
+		}
+	}
+	return a.Noise.WriteReadXmppNode(&xmpp.Node{
+		Tag: `iq`,
+		Attrs: []*xmpp.KeyValue{
+			{Key: `id`, Value: a.Noise.NextIqId_2()},
+			{Key: `to`, Value: gid},
+			{Key: `type`, Value: `set`},
+			{Key: `xmlns`, Value: `w:g2`},
+		},
+		Children: []*xmpp.Node{ch},
+	})
+}
+
+/*
+params:
+
+	jid: chat jid, or gid for group
+	expiration: optional, in seconds, 0 for off,
+		default to the account's disappearing mode
+*/
+func (c Core) SetChatEphemeral(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	jid, e := j.Get(`jid`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'jid'`))
+	}
+	exp, e := j.Get(`expiration`).TryInt()
+	if e != nil {
+		cfg, e := a.Store.GetConfig()
+		if e != nil {
+			return NewErrRet(e)
+		}
+		exp = int(cfg.DisappearingMode)
+	}
+	if exp < 0 {
+		return NewErrRet(errors.New(`'expiration' can't be negative`))
+	}
+	expiration := uint32(exp)
+
+	var rj *ajson.Json
+	if strings.HasSuffix(jid, `@g.us`) {
+		nr, e := a.set_group_ephemeral(jid, expiration)
+		if e != nil {
+			return NewErrRet(e)
+		}
+		rj = NewJsonRet(nr.ToJson())
+		// eg: not admin, <iq type="error"><error code="403" text="forbidden"/></iq>
+		if t, _ := nr.GetAttr(`type`); t == `error` {
+			return rj
+		}
+	} else {
+		mj := ajson.New()
+		mj.Set(`type`, `ephemeral_setting`)
+		mj.Set(`ephemeralExpiration`, expiration)
+
+		j.Set(`media_type`, `protocol`)
+		j.Set(`media`, mj.Data())
+
+		rj = c.SendMsg(j)
+		if rj.Get(`ErrCode`).Int() != 0 {
+			return rj
+		}
+	}
+
+	if e := a.Store.SetChatEphemeral(
+		clear_jid_device(jid), expiration, time.Now().Unix(),
+	); e != nil {
+		return NewErrRet(e)
+	}
+	return rj
+}
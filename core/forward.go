@@ -0,0 +1,151 @@
+package core
+
+import (
+	"strings"
+
+	"ajson"
+	"wa/pb"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+func is_forwardable(t pb.Media_Type) bool {
+	switch t {
+	case pb.Media_Text, pb.Media_Url,
+		pb.Media_Image, pb.Media_Video, pb.Media_Ptt,
+		pb.Media_Document, pb.Media_Sticker,
+		pb.Media_Contact, pb.Media_Contact_Array,
+		pb.Media_Location:
+		return true
+	}
+	return false
+}
+
+// the media of a received message from message cache,
+// or a message sent by us, the cache can also have stub docs
+// of sent messages that are reacted/edited/revoked, without content
+func (a *Acc) stored_media(msg_id string) (Media, error) {
+	if m, e := a.Store.GetMessage(msg_id); e == nil {
+		if m.Revoked {
+			return nil, errors.New(`message revoked`)
+		}
+		if m.Decrypted {
+			return NewMediaFromBytes(pb.Media_Type(m.MediaType), m.DecMedia)
+		}
+	}
+
+	sm, e := a.Store.GetSentMessage(msg_id)
+	if e != nil {
+		return nil, errors.Wrap(e, `message not found`)
+	}
+	pm := &pb.Message{}
+	if e := proto.Unmarshal(sm.Plain, pm); e != nil {
+		return nil, e
+	}
+	return (&MessageContent{P: pm}).GetMedia(), nil
+}
+
+// only media on cdn can expire, not contact/location
+func on_cdn(media Media) bool {
+	return len(media.DirectPath()) > 0 && media.MediaKey() != nil
+}
+
+func forwarding_score(media Media) uint32 {
+	pm := &pb.Message{}
+	media.FillMessage(pm)
+	return message_context_info(pm).GetForwardingScore()
+}
+
+/*
+Forward a stored message, media is sent with the same cdn keys,
+re-uploaded only if it's expired on cdn.
+
+params:
+
+	msg_id: the message to forward, received or sent by us
+	to: jid/gid, or array of them
+	path: optional, file of the media in the media dir, for re-uploading if expired
+
+result:
+
+	{"jid1": {ErrCode: 0, ...}, "gid1": {...}}
+*/
+func (c Core) ForwardMsg(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	msg_id, e := j.Get(`msg_id`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'msg_id'`))
+	}
+	to, e := j.Get(`to`).TryStringArray()
+	if e != nil {
+		jid, e := j.Get(`to`).TryString()
+		if e != nil {
+			return NewErrRet(errors.New(`missing 'to'`))
+		}
+		to = []string{jid}
+	}
+
+	media, e := a.stored_media(msg_id)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	if !is_forwardable(media.Type()) || is_view_once(media) {
+		return NewErrRet(errors.New(`message can't be forwarded`))
+	}
+
+	score := forwarding_score(media) + 1
+
+	// re-upload if expired
+	if on_cdn(media) {
+		expired, e := a.cdn_expired(media)
+		if e != nil {
+			return NewErrRet(e)
+		}
+		if expired {
+			path, e := j.Get(`path`).TryString()
+			if e != nil || len(path) == 0 {
+				return NewErrRet(errors.New(`media expired on cdn, 'path' required for re-uploading`))
+			}
+			mj := media.ToJson()
+			mj.Set(`path`, path)
+			uploaded, e := a.upload_media(media.Type(), mj)
+			if e != nil {
+				return NewErrRet(e)
+			}
+			// only the cdn fields are changed, keep the rest of the stored proto
+			pm, up := &pb.Message{}, &pb.Message{}
+			media.FillMessage(pm)
+			uploaded.FillMessage(up)
+			proto.Merge(pm, up)
+			media = (&MessageContent{P: pm}).GetMedia()
+		}
+	}
+	stored := media.Serialize()
+
+	ret := ajson.New()
+	for _, jid := range to {
+		// a fresh copy for each target, the context info is replaced
+		// with the forwarding one, the original quote/mentions dropped
+		fwd, e := NewMediaFromBytes(media.Type(), stored)
+		if e != nil {
+			return NewErrRet(e)
+		}
+		req := ajson.New()
+		req.Set(`forwarding_score`, score)
+
+		var rj *ajson.Json
+		if strings.HasSuffix(jid, `@g.us`) {
+			req.Set(`gid`, jid)
+			rj = a.send_group_msg(req, fwd)
+		} else {
+			req.Set(`jid`, jid)
+			rj = a.send_msg(req, fwd)
+		}
+		ret.Set(jid, rj.Data())
+	}
+	return NewJsonRet(ret)
+}
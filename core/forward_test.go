@@ -0,0 +1,44 @@
+package core
+
+import (
+	"testing"
+
+	"wa/pb"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestForwardLocation(t *testing.T) {
+	loc := &Location{P: &pb.Location{
+		DegreesLatitude:  proto.Float64(48.8584),
+		DegreesLongitude: proto.Float64(2.2945),
+		Name:             proto.String(`Eiffel Tower`),
+		ContextInfo: &pb.ContextInfo{
+			IsForwarded:     proto.Bool(true),
+			ForwardingScore: proto.Uint32(2),
+		},
+	}}
+	media, e := NewMediaFromBytes(pb.Media_Location, loc.Serialize())
+	if e != nil {
+		t.Fatal(e)
+	}
+	if !is_forwardable(media.Type()) {
+		t.Fatal(`location not forwardable`)
+	}
+	// not on cdn, so never checked for expiry
+	if on_cdn(media) {
+		t.Fatal(`location treated as cdn media`)
+	}
+	if score := forwarding_score(media); score != 2 {
+		t.Fatalf("forwarding score %d, want 2", score)
+	}
+
+	img := &Image{P: &pb.Image{DirectPath: proto.String(`/v/t62.7118-24/1`)}}
+	if on_cdn(img) {
+		t.Fatal(`image without media key treated as cdn media`)
+	}
+	img.P.MediaKey = make([]byte, 32)
+	if !on_cdn(img) {
+		t.Fatal(`image not treated as cdn media`)
+	}
+}
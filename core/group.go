@@ -6,6 +6,7 @@ import (
 	"ajson"
 	"algo"
 	"arand"
+	"wa/stanza"
 	"wa/xmpp"
 
 	"github.com/pkg/errors"
@@ -293,6 +294,10 @@ func (c Core) RemoveGroupMember(j *ajson.Json) *ajson.Json {
 		return NewErrRet(e)
 	}
 
+	if e := a.rotate_group_sender_key(gid); e != nil {
+		a.Log.Error("fail rotate sender key: %s", e.Error())
+	}
+
 	return NewJsonRet(nr.ToJson())
 }
 func (c Core) ListGroup(j *ajson.Json) *ajson.Json {
@@ -343,7 +348,7 @@ func (c Core) ListGroup(j *ajson.Json) *ajson.Json {
 			// creator can be empty
 			creator := attrs[`creator`]
 
-			var members []string
+			var members, admins []string
 
 			for _, mbr := range g.Children {
 				switch mbr.Tag {
@@ -355,11 +360,15 @@ func (c Core) ListGroup(j *ajson.Json) *ajson.Json {
 					if ok {
 						members = append(members, jid)
 					}
+					// `admin` or `superadmin`
+					if t := mbr_attrs[`type`]; ok && strings.HasSuffix(t, `admin`) {
+						admins = append(admins, jid)
+					}
 				}
 			}
 
 			if e := a.Store.CreateGroup(
-				id+`@g.us`, subject, creator, members,
+				id+`@g.us`, subject, creator, members, admins,
 			); e != nil {
 				continue
 			}
@@ -370,126 +379,83 @@ func (c Core) ListGroup(j *ajson.Json) *ajson.Json {
 }
 
 func New_Hook_GroupCreate(a *Acc) func(...any) error {
-	// Decrypt and Replace node.Data
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		attrs := n.MapAttrs()
-
-		// MUST have attr `from`,`participant`
-		from, ok1 := attrs[`from`]
-		type_, ok2 := attrs[`type`]
-
-		if !ok1 || !ok2 {
-			return nil
-		}
-		if type_ != `w:gp2` {
-			return nil
-		}
-		chCreate, ok := n.FindChildByTag(`create`)
-		if !ok {
+		nt, ok := args[0].(*stanza.Notification)
+		if !ok || nt.Group == nil {
 			return nil
 		}
-		chGroup, ok := chCreate.FindChildByTag(`group`)
-		if !ok {
+		if nt.Group.Action != `create` {
 			return nil
 		}
-		grpAttrs := chGroup.MapAttrs()
-		creator, ok1 := grpAttrs[`creator`]
-		subject, ok2 := grpAttrs[`subject`]
-		if !ok1 || !ok2 {
-			return nil
-		}
-
-		members := []string{}
-		for _, ch := range chGroup.Children {
-			if ch.Tag == `participant` {
-				m := ch.MapAttrs()
-				jid, ok := m[`jid`]
-				if !ok {
-					continue
-				}
-				members = append(members, jid)
-			}
-		}
+		info := nt.Group.Info
 
 		return a.Store.CreateGroup(
-			from, subject, creator, members)
+			nt.From, info.Subject, info.Creator, info.Members, info.Admins)
 	}
 }
-func New_Hook_GroupLeave(a *Acc) func(...any) error {
-	// Decrypt and Replace node.Data
+func New_Hook_GroupAdminChange(a *Acc) func(...any) error {
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		attrs := n.MapAttrs()
-
-		// MUST have attr `from`,`participant`
-		from, ok1 := attrs[`from`] // gid
-		type_, ok2 := attrs[`type`]
-
-		if !ok1 || !ok2 {
+		nt, ok := args[0].(*stanza.Notification)
+		if !ok || nt.Group == nil {
 			return nil
 		}
-		if type_ != `w:gp2` {
+		switch nt.Group.Action {
+		case `promote`:
+			return a.Store.SetGroupAdmin(nt.From, nt.Group.Participants, true)
+		case `demote`:
+			return a.Store.SetGroupAdmin(nt.From, nt.Group.Participants, false)
+		}
+		return nil
+	}
+}
+func New_Hook_GroupLeave(a *Acc) func(...any) error {
+	return func(args ...any) error {
+		nt, ok := args[0].(*stanza.Notification)
+		if !ok || nt.Group == nil {
 			return nil
 		}
-		chRemove, ok := n.FindChildByTag(`remove`)
-		if !ok {
+		if nt.Group.Action != `remove` {
 			return nil
 		}
+		from := nt.From // gid
+
 		my_jid, e := a.Store.GetMyJid()
 		if e != nil {
 			return e
 		}
-		for _, ch := range chRemove.Children {
-			if ch.Tag == `participant` {
-				m := ch.MapAttrs()
-				if jid, ok := m[`jid`]; ok {
-					if jid == my_jid { // self left group, clear group/members
-						if e := a.Store.RemoveGroup(from); e != nil {
-							return e
-						}
-					} else { // other leave group
-						if e := a.Store.RemoveOneGroupMember(from, jid); e != nil {
-							return e
-						}
-
-					}
+		rotate := false
+		for _, jid := range nt.Group.Participants {
+			if jid == my_jid { // self left group, clear group/members
+				if e := a.Store.RemoveGroup(from); e != nil {
+					return e
 				}
+			} else { // other leave group
+				if e := a.Store.RemoveOneGroupMember(from, jid); e != nil {
+					return e
+				}
+				rotate = true
 			}
 		}
 
+		// the removed member still has our sender key
+		if rotate {
+			return a.rotate_group_sender_key(from)
+		}
 		return nil
 	}
 }
 func New_Hook_GroupAdd(a *Acc) func(...any) error {
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		attrs := n.MapAttrs()
-
-		from, ok1 := attrs[`from`] // gid
-		type_, ok2 := attrs[`type`]
-
-		if !ok1 || !ok2 {
+		nt, ok := args[0].(*stanza.Notification)
+		if !ok || nt.Group == nil {
 			return nil
 		}
-		if type_ != `w:gp2` {
+		if nt.Group.Action != `add` {
 			return nil
 		}
-		chAdd, ok := n.FindChildByTag(`add`)
-		if !ok {
-			return nil
-		}
-		for _, ch := range chAdd.Children {
-			if ch.Tag == `participant` {
-				m := ch.MapAttrs()
-				if jid, ok := m[`jid`]; ok {
-					if e := a.Store.AddGroupMember(from, jid); e != nil {
-						return e
-					}
-				}
+		for _, jid := range nt.Group.Participants {
+			if e := a.Store.AddGroupMember(nt.From, jid); e != nil { // nt.From: gid
+				return e
 			}
 		}
 
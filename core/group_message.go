@@ -19,6 +19,7 @@ import (
 	"wa/signal/groups"
 	"wa/signal/protocol"
 	"wa/signal/session"
+	"wa/stanza"
 	"wa/xmpp"
 
 	"github.com/pkg/errors"
@@ -70,44 +71,30 @@ func phash(me string, participants []string) string {
 	return `2:` + algo.B64Enc(sha[:6])
 }
 
-func find_whisper_node(n *xmpp.Node) (*xmpp.Node, uint32, bool) {
-	var msg_type string
-	wmNode, has_wm := n.FindChild(func(ch *xmpp.Node) bool {
-		msg_type, _ = ch.GetAttr(`type`)
-		return msg_type == `pkmsg` || msg_type == `msg`
-	})
-	if has_wm {
-		return wmNode, msg_type_int(msg_type), true
-	} else {
-		return nil, 0, false
-	}
-}
-func find_sk_node(n *xmpp.Node) (*xmpp.Node, bool) {
-	return n.FindChildWithAttr(`type`, `skmsg`)
-}
-
 func (c Core) SendGroupMsg(j *ajson.Json) *ajson.Json {
 	a, e := GetAccFromJson(j)
 	if e != nil {
 		return NewErrRet(e)
 	}
 
-	media_t := MediaTypeInt(j.Get(`media_type`).String())
-	if media_t == pb.Media_Unknown {
-		return NewErrRet(errors.New(`unsupported media_type`))
-	}
-	// parse media from json
-	media, e := NewMedia(media_t)
+	media, e := a.media_from_json(j)
 	if e != nil {
 		return NewErrRet(e)
 	}
-	mj, ok := j.TryGet(`media`)
-	if !ok {
-		return NewErrRet(errors.New(`fail parse media json`))
+	return a.send_group_msg(j, media)
+}
+
+// SendGroupMsg with parsed `media`
+func (a *Acc) send_group_msg(j *ajson.Json, media Media) *ajson.Json {
+	media_t := media.Type()
+
+	if e := a.check_interactive(media); e != nil {
+		return NewErrRet(e)
 	}
-	// parse Media from json
-	if media.FillFromJson(mj) != nil {
-		return NewErrRet(errors.New(`fail parse media json`))
+	// quoted/mentions
+	ci, e := context_info_from_json(j)
+	if e != nil {
+		return NewErrRet(e)
 	}
 
 	dev, e := a.Store.GetDev()
@@ -130,17 +117,20 @@ func (c Core) SendGroupMsg(j *ajson.Json) *ajson.Json {
 	if e != nil {
 		return NewErrRet(e)
 	}
+	recipients := participants
+
+	// own companion devices need the sender key too
+	own_jids, e := a.own_device_jids()
+	if e != nil {
+		return NewErrRet(e)
+	}
+	participants = append(participants, own_jids...)
 
 	recid_me := dev.Cc + dev.Phone
 	addr_me := protocol.NewSignalAddress(recid_me, 0)
 
 	skn_me := protocol.NewSenderKeyName(gid, addr_me)
 
-	map_sb, e := a.ensure_session_builder(participants)
-	if e != nil {
-		return NewErrRet(errors.Wrap(e, `fail ensure_session_builder`))
-	}
-
 	gsb := groups.NewGroupSessionBuilder(a.Store)
 
 	grp_cipher := groups.NewGroupCipher(gsb, skn_me, a.Store)
@@ -151,9 +141,33 @@ func (c Core) SendGroupMsg(j *ajson.Json) *ajson.Json {
 		return NewErrRet(e)
 	}
 
+	// only send skdm to devices that don't have current sender key
+	distributed, e := a.sender_key_distributed(gid, skdm.ID())
+	if e != nil {
+		return NewErrRet(e)
+	}
+	skdm_jids := []string{}
+	for _, jid := range participants {
+		if !distributed[jid] {
+			skdm_jids = append(skdm_jids, jid)
+		}
+	}
+
+	map_sb, e := a.ensure_session_builder(skdm_jids)
+	if e != nil {
+		return NewErrRet(errors.Wrap(e, `fail ensure_session_builder`))
+	}
+
 	// sender key msg
 	pbm := &pb.Message{}
 	media.FillMessage(pbm)
+	set_context_info(pbm, ci)
+	a.stamp_ephemeral(pbm, gid)
+	if view_once, _ := j.Get(`view_once`).TryBool(); view_once {
+		if e := wrap_view_once(pbm); e != nil {
+			return NewErrRet(e)
+		}
+	}
 
 	p, _ := proto.Marshal(pbm)
 	padded := crypto.RandomPadMsg(p)
@@ -167,7 +181,7 @@ func (c Core) SendGroupMsg(j *ajson.Json) *ajson.Json {
 	ptcps := &xmpp.Node{
 		Tag: `participants`,
 	}
-	for _, jid := range participants {
+	for _, jid := range skdm_jids {
 		sb := map_sb[jid] // guaranteed ok
 
 		// build protobuf + pad
@@ -246,26 +260,48 @@ func (c Core) SendGroupMsg(j *ajson.Json) *ajson.Json {
 
 	send_begin := time.Now()
 
-	nr, e := a.Noise.WriteReadXmppNode(&xmpp.Node{
-		Tag: `message`,
-		Attrs: []*xmpp.KeyValue{
-			{Key: `id`, Value: msg_id},
-			{Key: `phash`, Value: phash(recid_me, participants)},
-			{Key: `to`, Value: gid},
-			{Key: `type`, Value: media.MsgCategory()},
-		},
-		Children: []*xmpp.Node{
-			{
-				Tag:   `enc`,
-				Attrs: attrs,
-				Data:  skm.SignedSerialize(),
-			},
-			ptcps,
+	children := []*xmpp.Node{
+		{
+			Tag:   `enc`,
+			Attrs: attrs,
+			Data:  skm.SignedSerialize(),
 		},
+	}
+	// no `participants` if all devices have the sender key
+	if len(ptcps.Children) > 0 {
+		children = append(children, ptcps)
+	}
+
+	msg_attrs := []*xmpp.KeyValue{
+		{Key: `id`, Value: msg_id},
+		{Key: `phash`, Value: phash(recid_me, participants)},
+		{Key: `to`, Value: gid},
+		{Key: `type`, Value: media.MsgCategory()},
+	}
+	if edit := msg_edit_attr(media); edit != `` {
+		msg_attrs = append(msg_attrs, &xmpp.KeyValue{Key: `edit`, Value: edit})
+	}
+
+	nr, e := a.Noise.WriteReadXmppNode(&xmpp.Node{
+		Tag:      `message`,
+		Attrs:    msg_attrs,
+		Children: children,
 	})
 	if e != nil {
 		return NewErrRet(e)
 	}
+	// rejected by server, nothing is delivered
+	if code, failed := ack_error(nr); failed {
+		a.Log.Warning("group msg %s rejected, error: %s", msg_id, code)
+		return NewJsonRet(nr.ToJson())
+	}
+
+	for _, jid := range skdm_jids {
+		recid, devid, _ := split_jid(jid)
+		if e := a.Store.AddSenderKeyDistribution(gid, skdm.ID(), uint(recid), devid); e != nil {
+			a.Log.Error("fail save sender key distribution: %s", e.Error())
+		}
+	}
 
 	// only save on success, cause I don't know the fail value for messageSendResult
 	{
@@ -281,27 +317,31 @@ func (c Core) SendGroupMsg(j *ajson.Json) *ajson.Json {
 			a.Log.Error(`fail WamMessageSend: ` + er.Error())
 		}
 	}
+	a.on_msg_sent(msg_id, gid, pbm, recipients, media)
+
 	return NewJsonRet(nr.ToJson())
 }
 func New_Hook_GroupMsg(a *Acc) func(...any) error {
-	// Decrypt and Replace node.Data
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		attrs := n.MapAttrs()
-
-		// MUST have attr `id`,`participant`,`from`
-		msg_id, ok1 := attrs[`id`]
-		participant, ok2 := attrs[`participant`]
-		gid, ok3 := attrs[`from`]
-		t, ok4 := attrs[`t`]
+		m, ok := args[0].(*stanza.Message)
+		if !ok {
+			return nil
+		}
 
-		if !ok1 || !ok2 || !ok3 || !ok4 {
+		// MUST have attr `participant`
+		if !m.IsGroup() {
 			return nil
 		}
 
+		n := m.Node()
+		attrs := n.MapAttrs()
+
+		msg_id := m.Id
+		participant := m.Participant
+		gid := m.From
+
 		// TODO, handle broadcast
-		if strings.Contains(gid, `@broadcast`) {
+		if m.IsBroadcast() && gid != StatusBroadcast {
 			a.Store.EnsureMessage(
 				msg_id, xmpp.NewWriter().WriteNode(n))
 			a.receipt_group_msg_receive(msg_id, gid, participant)
@@ -316,9 +356,9 @@ func New_Hook_GroupMsg(a *Acc) func(...any) error {
 		gsb := groups.NewGroupSessionBuilder(a.Store)
 		skn := protocol.NewSenderKeyName(gid, peer_addr)
 
-		// 1. get current wmNode/skNode
-		wmNode, msg_type, has_wm := find_whisper_node(n)
-		skNode, has_sk := find_sk_node(n)
+		// 1. get current wm/sk
+		wm, has_wm := m.WhisperEnc()
+		sk, has_sk := m.SenderKeyEnc()
 
 		// if fail, send retry packet
 		ev := event.New[string]()
@@ -365,18 +405,17 @@ func New_Hook_GroupMsg(a *Acc) func(...any) error {
 				if e != nil {
 					return e
 				}
-				attrs := n.Children[0].MapAttrs()
-				cipher_type, _ := attrs[`type`]
-				cipher_ver, _ := attrs[`v`]
+				// the first `enc`
+				cipher := m.Enc[0]
 
-				timestamp, _ := strconv.Atoi(t)
+				timestamp := int(m.T)
 				international := !strings.HasPrefix(participant, dev.Cc)
 
 				if e := a.wam_e2e_message_recv(
 					media.Type(),
 					timestamp,
-					wam_cipher_text_type(cipher_type),
-					wam_cipher_text_ver(cipher_ver),
+					wam_cipher_text_type(cipher.Type),
+					wam_cipher_text_ver(cipher.V),
 					1, /*dest*/
 				); e != nil {
 					a.Log.Error(`fail wam_e2e_message_recv: ` + e.Error())
@@ -392,21 +431,17 @@ func New_Hook_GroupMsg(a *Acc) func(...any) error {
 				}
 			}
 
-			// modify node
-			n.Attrs = append(n.Attrs, &xmpp.KeyValue{
-				Key:   `media`,
-				Value: media.ToJson().ToString(),
-			})
-			n.Attrs = append(n.Attrs, &xmpp.KeyValue{
-				Key:   `media_type`,
-				Value: MediaTypeStr(media.Type()),
-			})
-
-			// too long, not necessary to clients
-			n.Children = n.Children[:0]
-
-			return a.Store.SaveDecodedMessage(
-				msg_id, uint32(media.Type()), media.Serialize())
+			// attach decrypted content, pushed to client
+			m.MediaType = MediaTypeStr(media.Type())
+			m.Media = media
+
+			if e := a.Store.SaveDecodedMessage(
+				msg_id, uint32(media.Type()), media.Serialize()); e != nil {
+				return e
+			}
+			a.set_message_expiration(msg_id, m.T, content)
+
+			return a.handle_decoded_message(m, gid, participant, media)
 		})
 
 		// 0. save msg to db
@@ -432,10 +467,10 @@ func New_Hook_GroupMsg(a *Acc) func(...any) error {
 			))
 		}
 
-		// 2. process wmNode
+		// 2. process wm
 		if has_wm {
 			content, e := a.decodeGroupMsgSkdmFromJid(
-				participant, wmNode.Data, msg_type)
+				participant, wm.Data, msg_type_int(wm.Type))
 			if e != nil {
 				return ev.Fire(`retry`, nil)
 			}
@@ -459,7 +494,12 @@ func New_Hook_GroupMsg(a *Acc) func(...any) error {
 				a.Log.Error("(should never see this, report bug) fail read node from group msg: %s: %s", msg_id, e.Error())
 				return ev.Fire(`retry`, nil)
 			}
-			skNode, has_sk = find_sk_node(pn)
+			pm_, e := stanza.DecodeMessage(pn)
+			if e != nil {
+				a.Log.Error("(should never see this, report bug) fail decode group msg: %s: %s", msg_id, e.Error())
+				return ev.Fire(`retry`, nil)
+			}
+			sk, has_sk = pm_.SenderKeyEnc()
 			if !has_sk {
 				a.Log.Error("(should never see this, report bug) group msg with no sk: %s", msg_id)
 				return ev.Fire(`retry`, nil)
@@ -467,7 +507,7 @@ func New_Hook_GroupMsg(a *Acc) func(...any) error {
 		}
 
 		// process sk node
-		content, e := a.decodeSkMsg(gsb, skn, skNode.Data)
+		content, e := a.decodeSkMsg(gsb, skn, sk.Data)
 		if e != nil {
 			return ev.Fire(`retry`, nil)
 		}
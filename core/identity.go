@@ -0,0 +1,306 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+
+	"ajson"
+	"algo"
+	"wa/def"
+	"wa/signal/fingerprint"
+	"wa/signal/keys/identity"
+	"wa/signal/protocol"
+	"wa/signal/util/bytehelper"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const FingerprintIterations = 5200
+
+func (a *Acc) fingerprint(recid uint64, remote_pub []byte) (*fingerprint.Fingerprint, error) {
+	dev, e := a.Store.GetDev()
+	if e != nil {
+		return nil, e
+	}
+	my_iden, e := a.Store.GetIdentityKeyPair()
+	if e != nil {
+		return nil, e
+	}
+	remote := identity.NewKeyFromBytes(bytehelper.SliceToArray(remote_pub))
+
+	return fingerprint.NewNumericFingerprintGenerator(FingerprintIterations).CreateFor(
+		dev.Cc+dev.Phone, strconv.FormatUint(recid, 10),
+		my_iden.PublicKey(), remote,
+	), nil
+}
+
+// the 60 digits safety number between me and peer's identity key
+func (a *Acc) safety_number(recid uint64, remote_pub []byte) (string, error) {
+	fp, e := a.fingerprint(recid, remote_pub)
+	if e != nil {
+		return ``, e
+	}
+	return fp.Display().DisplayText(), nil
+}
+
+func (a *Acc) identity_history_json(h *def.IdentityHistory) (*ajson.Json, error) {
+	old_sn, e := a.safety_number(uint64(h.RecipientId), h.OldKey)
+	if e != nil {
+		return nil, e
+	}
+	new_sn, e := a.safety_number(uint64(h.RecipientId), h.NewKey)
+	if e != nil {
+		return nil, e
+	}
+
+	j := ajson.New()
+	j.Set(`id`, h.ID.Hex())
+	j.Set(`jid`, build_jid(uint64(h.RecipientId), h.DeviceId))
+	j.Set(`status`, h.Status)
+	j.Set(`old_safety_number`, old_sn)
+	j.Set(`new_safety_number`, new_sn)
+	j.Set(`created_at`, h.CreatedAt.Unix())
+	return j, nil
+}
+
+// called by Store when peer's identity key changed
+func (a *Acc) on_identity_change(h *def.IdentityHistory) {
+	j, e := a.identity_history_json(h)
+	if e != nil {
+		a.Log.Error("fail build identity_changed: %s", e.Error())
+		return
+	}
+	a.push(`identity_changed`, j)
+}
+
+func identity_trust_int(s string) (int8, bool) {
+	switch s {
+	case `tofu`:
+		return def.IdentityTrust_Tofu, true
+	case `block_until_approved`:
+		return def.IdentityTrust_BlockUntilApproved, true
+	case `always_accept`:
+		return def.IdentityTrust_AlwaysAccept, true
+	}
+	return 0, false
+}
+
+// param `policy`: `tofu`/`block_until_approved`/`always_accept`
+func (c Core) SetIdentityTrust(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	policy, e := j.Get(`policy`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'policy'`))
+	}
+	trust, ok := identity_trust_int(policy)
+	if !ok {
+		return NewErrRet(errors.New(`wrong param 'policy'`))
+	}
+
+	e = a.Store.ModifyConfig(bson.M{
+		`IdentityTrust`: trust,
+	})
+	if e != nil {
+		return NewErrRet(e)
+	}
+	return NewSucc()
+}
+
+func (a *Acc) list_identity_history(recid uint64, devid uint32, status string) *ajson.Json {
+	hs, e := a.Store.ListIdentityHistory(uint(recid), devid, status)
+	if e != nil {
+		return NewErrRet(e)
+	}
+
+	list := []any{}
+	for _, h := range hs {
+		hj, e := a.identity_history_json(h)
+		if e != nil {
+			return NewErrRet(e)
+		}
+		list = append(list, hj.Data())
+	}
+	rj := NewSucc()
+	rj.Set(`list`, list)
+	return rj
+}
+
+// identity changes waiting for approve, with policy `block_until_approved`
+func (c Core) ListPendingIdentity(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	return a.list_identity_history(0, 0, def.IdentityStatus_Pending)
+}
+
+// optional param `jid`, list all if not set
+func (c Core) ListIdentityHistory(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	var recid uint64
+	var devid uint32
+	if jid, e := j.Get(`jid`).TryString(); e == nil {
+		recid, devid, e = split_jid(jid)
+		if e != nil {
+			return NewErrRet(errors.Wrap(e, `wrong param 'jid'`))
+		}
+	}
+	return a.list_identity_history(recid, devid, ``)
+}
+
+func (a *Acc) get_pending_identity(j *ajson.Json) (*def.IdentityHistory, error) {
+	id, e := j.Get(`id`).TryString()
+	if e != nil {
+		return nil, errors.New(`missing 'id'`)
+	}
+	oid, e := primitive.ObjectIDFromHex(id)
+	if e != nil {
+		return nil, errors.New(`wrong param 'id'`)
+	}
+	h, e := a.Store.GetIdentityHistoryById(oid)
+	if e != nil {
+		return nil, e
+	}
+	if h.Status != def.IdentityStatus_Pending {
+		return nil, errors.New(`identity is not pending: ` + h.Status)
+	}
+	return h, nil
+}
+
+// accept the new key, session will be rebuilt
+func (c Core) ApproveIdentity(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	h, e := a.get_pending_identity(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+
+	addr := protocol.NewSignalAddress(fmt.Sprintf("%d", h.RecipientId), h.DeviceId)
+
+	e = a.Store.SaveIdentityKey(addr, h.NewKey)
+	if e != nil {
+		return NewErrRet(e)
+	}
+
+	// the old session/senderkey are useless
+	a.Store.DeleteSession(addr)
+	a.Store.DeleteSenderKey(addr)
+
+	if e := a.Store.SetIdentityHistoryStatus(h.ID, def.IdentityStatus_Approved); e != nil {
+		return NewErrRet(e)
+	}
+	a.Log.Info("identity approved: %s", build_jid(uint64(h.RecipientId), h.DeviceId))
+	return NewSucc()
+}
+
+// keep blocking the new key
+func (c Core) RejectIdentity(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	h, e := a.get_pending_identity(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	if e := a.Store.SetIdentityHistoryStatus(h.ID, def.IdentityStatus_Rejected); e != nil {
+		return NewErrRet(e)
+	}
+	return NewSucc()
+}
+
+func (a *Acc) get_peer_fingerprint(j *ajson.Json) (
+	*protocol.SignalAddress, *def.Identity, *fingerprint.Fingerprint, error,
+) {
+	jid, e := j.Get(`jid`).TryString()
+	if e != nil {
+		return nil, nil, nil, errors.New(`missing 'jid'`)
+	}
+	recid, devid, e := split_jid(jid)
+	if e != nil {
+		return nil, nil, nil, errors.Wrap(e, `wrong param 'jid'`)
+	}
+	addr := protocol.NewSignalAddress(fmt.Sprintf("%d", recid), devid)
+
+	iden, e := a.Store.GetIdentity(addr)
+	if e != nil {
+		return nil, nil, nil, errors.Wrap(e, `no identity for `+jid)
+	}
+	fp, e := a.fingerprint(recid, iden.PublicKey)
+	if e != nil {
+		return nil, nil, nil, e
+	}
+	return addr, iden, fp, nil
+}
+
+// returns:
+//
+//	`safety_number`: 60 digits for display
+//	`scannable`: base64 of the QR code payload
+//	`verified`: if verified by VerifyFingerprint
+func (c Core) GetFingerprint(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	_, iden, fp, e := a.get_peer_fingerprint(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	scan, e := fp.Scan().Serialize()
+	if e != nil {
+		return NewErrRet(e)
+	}
+
+	rj := NewSucc()
+	rj.Set(`safety_number`, fp.Display().DisplayText())
+	rj.Set(`scannable`, algo.B64Enc(scan))
+	rj.Set(`verified`, iden.Verified)
+	return rj
+}
+
+// param `scanned`: base64 of the QR code payload scanned from peer's device,
+// mark identity as verified if matches
+func (c Core) VerifyFingerprint(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	scanned_b64, e := j.Get(`scanned`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'scanned'`))
+	}
+	scanned, e := algo.B64Dec(scanned_b64)
+	if e != nil {
+		return NewErrRet(errors.New(`wrong param 'scanned', not base64`))
+	}
+	addr, _, fp, e := a.get_peer_fingerprint(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+
+	match, e := fp.Scan().CompareTo(scanned)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	if match {
+		if e := a.Store.SetIdentityVerified(addr, true); e != nil {
+			return NewErrRet(e)
+		}
+	}
+
+	rj := NewSucc()
+	rj.Set(`match`, match)
+	return rj
+}
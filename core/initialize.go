@@ -1,6 +1,7 @@
 package core
 
 import (
+	"strconv"
 	"time"
 
 	"ajson"
@@ -12,8 +13,10 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 )
 
+// <disappearing_mode duration="86400" t="xx"/>
+// the account default timer, used by SetChatEphemeral
 func (a *Acc) get_disappearing_mode() error {
-	_, e := a.Noise.WriteReadXmppNode(&xmpp.Node{
+	nr, e := a.Noise.WriteReadXmppNode(&xmpp.Node{
 		Tag: `iq`,
 		Attrs: []*xmpp.KeyValue{
 			{Key: `id`, Value: a.Noise.NextIqId_2()},
@@ -22,7 +25,21 @@ func (a *Acc) get_disappearing_mode() error {
 			{Key: `xmlns`, Value: `disappearing_mode`},
 		},
 	})
-	return e
+	if e != nil {
+		return e
+	}
+	ch, ok := nr.FindChildByTag(`disappearing_mode`)
+	if !ok {
+		return nil
+	}
+	duration, _ := ch.GetAttr(`duration`)
+	d, e := strconv.Atoi(duration)
+	if e != nil {
+		return nil
+	}
+	return a.Store.ModifyConfig(bson.M{
+		`DisappearingMode`: uint32(d),
+	})
 }
 func (a *Acc) get_block_list() error {
 	_, e := a.Noise.WriteReadXmppNode(&xmpp.Node{
@@ -769,6 +786,7 @@ func (c Core) Initialize(j *ajson.Json) *ajson.Json {
 	a.StartWamCron()
 	a.StartDailyCron()
 	a.StartMediaConnCron()
+	a.StartEphemeralCron()
 
 	return NewSucc()
 }
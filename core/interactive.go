@@ -0,0 +1,63 @@
+package core
+
+import (
+	"ajson"
+	"event"
+	"wa/stanza"
+
+	"github.com/pkg/errors"
+)
+
+// buttons/list/template can only be sent by business account
+func (a *Acc) check_interactive(media Media) error {
+	switch media.(type) {
+	case *Buttons, *List, *Template:
+	default:
+		return nil
+	}
+	dev, e := a.Store.GetDev()
+	if e != nil {
+		return e
+	}
+	if !dev.IsBusiness {
+		return errors.New(`interactive message requires business account`)
+	}
+	return nil
+}
+
+// push `button_response`/`list_response`/`template_reply`
+// with the selected id, instead of the raw message
+func (a *Acc) handle_interactive_response(
+	m *stanza.Message, chat, sender string, media Media,
+) error {
+	j := ajson.New()
+	j.Set(`chat`, clear_jid_device(chat))
+	j.Set(`sender`, clear_jid_device(sender))
+	j.Set(`id`, m.Id)
+	j.Set(`t`, m.T)
+
+	var tag string
+	switch x := media.(type) {
+	case *ButtonsResponse:
+		tag = `button_response`
+		j.Set(`target_id`, x.P.GetContextInfo().GetStanzaId())
+		j.Set(`selected_id`, x.P.GetSelectedButtonId())
+		j.Set(`selected_text`, x.P.GetSelectedDisplayText())
+	case *ListResponse:
+		tag = `list_response`
+		j.Set(`target_id`, x.P.GetContextInfo().GetStanzaId())
+		j.Set(`selected_id`, x.P.GetSingleSelectReply().GetSelectedRowId())
+		j.Set(`selected_text`, x.P.GetTitle())
+	case *TemplateReply:
+		tag = `template_reply`
+		j.Set(`target_id`, x.P.GetContextInfo().GetStanzaId())
+		j.Set(`selected_id`, x.P.GetSelectedId())
+		j.Set(`selected_text`, x.P.GetSelectedDisplayText())
+		j.Set(`selected_index`, x.P.GetSelectedIndex())
+	default:
+		return nil
+	}
+	a.push(tag, j)
+
+	return event.Stop
+}
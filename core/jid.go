@@ -1,6 +1,7 @@
 package core
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -47,3 +48,12 @@ func split_jid(jid string) (recid uint64, devid uint32, e error) {
 	}
 	return
 }
+
+// 8613322222222  0 -> 8613322222222@s.whatsapp.net
+// 8613322222222  1 -> 8613322222222.0:1@s.whatsapp.net
+func build_jid(recid uint64, devid uint32) string {
+	if devid == 0 {
+		return fmt.Sprintf("%d@s.whatsapp.net", recid)
+	}
+	return fmt.Sprintf("%d.0:%d@s.whatsapp.net", recid, devid)
+}
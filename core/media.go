@@ -1,13 +1,13 @@
 package core
 
 import (
-	"crypto/hmac"
-	"crypto/sha256"
 	"strconv"
 	"strings"
+	"time"
 
 	"ajson"
 	"algo"
+	"arand"
 	"wa/def"
 	"wa/pb"
 
@@ -38,6 +38,22 @@ func MediaTypeInt(t string) pb.Media_Type {
 		return pb.Media_Contact
 	case `contact_array`:
 		return pb.Media_Contact_Array
+	case `location`:
+		return pb.Media_Location
+	case `livelocation`:
+		return pb.Media_LiveLocation
+	case `poll_creation`:
+		return pb.Media_Poll_Creation
+	case `reaction`:
+		return pb.Media_Reaction
+	case `protocol`:
+		return pb.Media_Protocol
+	case `buttons`:
+		return pb.Media_Buttons
+	case `list`:
+		return pb.Media_List
+	case `template`:
+		return pb.Media_Template
 	}
 	return pb.Media_Unknown
 }
@@ -46,6 +62,8 @@ func NewMedia(
 ) (Media, error) {
 	var ret Media
 	switch media_t {
+	case pb.Media_Unknown:
+		ret = &TodoMedia{}
 	case pb.Media_Text:
 		ret = &Text{}
 	case pb.Media_Url:
@@ -64,6 +82,30 @@ func NewMedia(
 		ret = &Contact{P: &pb.Contact{}}
 	case pb.Media_Contact_Array:
 		ret = &ContactArray{P: &pb.ContactArray{}}
+	case pb.Media_Location:
+		ret = &Location{P: &pb.Location{}}
+	case pb.Media_LiveLocation:
+		ret = &LiveLocation{P: &pb.LiveLocation{}}
+	case pb.Media_Poll_Creation:
+		ret = &PollCreation{P: &pb.PollCreation{}}
+	case pb.Media_Poll_Update:
+		ret = &PollUpdate{P: &pb.PollUpdate{}}
+	case pb.Media_Reaction:
+		ret = &Reaction{P: &pb.Reaction{}}
+	case pb.Media_Protocol:
+		ret = &Protocol{P: &pb.ProtocolMessage{}}
+	case pb.Media_Buttons:
+		ret = &Buttons{P: &pb.ButtonsMessage{}}
+	case pb.Media_Buttons_Response:
+		ret = &ButtonsResponse{P: &pb.ButtonsResponseMessage{}}
+	case pb.Media_List:
+		ret = &List{P: &pb.ListMessage{}}
+	case pb.Media_List_Response:
+		ret = &ListResponse{P: &pb.ListResponseMessage{}}
+	case pb.Media_Template:
+		ret = &Template{P: &pb.TemplateMessage{}}
+	case pb.Media_Template_Reply:
+		ret = &TemplateReply{P: &pb.TemplateButtonReplyMessage{}}
 	default:
 		return nil, errors.New(`unsupported media type: ` + strconv.Itoa(int(media_t)))
 	}
@@ -80,6 +122,10 @@ func NewMediaFromBytes(
 
 	e = ret.DeSerialize(pb_data)
 
+	// stored before its type was supported
+	if x, ok := ret.(*TodoMedia); ok && e == nil {
+		return x.Reparse(), nil
+	}
 	return ret, e
 }
 
@@ -106,6 +152,7 @@ type Media interface {
 	MsgUrl() string
 	DirectPath() string
 	EncFileHash() []byte
+	FileHash() []byte
 	CdnHost(*def.Cdn) string
 }
 
@@ -122,6 +169,9 @@ func (*NoneCdnMedia) DirectPath() string {
 func (*NoneCdnMedia) EncFileHash() []byte {
 	return nil
 }
+func (*NoneCdnMedia) FileHash() []byte {
+	return nil
+}
 func (*NoneCdnMedia) CdnHost(cdn *def.Cdn) string {
 	return ``
 }
@@ -137,31 +187,59 @@ func (*NoneEncryptedMedia) MediaKey() []byte {
 	return nil
 }
 
-// TodoMedia
+// TodoMedia, unsupported message,
+// the whole Message.proto is kept for parsing again later
 type TodoMedia struct {
 	NoneCdnMedia
 	NoneEncryptedMedia
+	Raw []byte
 }
 
 func (s *TodoMedia) Type() pb.Media_Type {
 	return pb.Media_Unknown
 }
 func (s *TodoMedia) DeSerialize(bs []byte) error {
+	s.Raw = bs
 	return nil
 }
 func (s *TodoMedia) Serialize() []byte {
-	return nil
+	return s.Raw
 }
 func (s *TodoMedia) FillFromJson(*ajson.Json) error {
-	return nil
+	return errors.New(`unsupported media type`)
 }
+
+/*
+	{
+		raw: base64 of the Message.proto,
+		fields: {"1": ..., "3": {"1": ...}, ...}  // field number -> value
+	}
+*/
 func (s *TodoMedia) ToJson() *ajson.Json {
-	return ajson.New()
+	j := ajson.New()
+	if len(s.Raw) == 0 {
+		return j
+	}
+	j.Set(`raw`, algo.B64Enc(s.Raw))
+	if fields, ok := proto_fields(s.Raw, 0); ok {
+		j.Set(`fields`, fields)
+	}
+	return j
 }
 func (s *TodoMedia) MsgCategory() string {
 	return ``
 }
 func (s *TodoMedia) FillMessage(m *pb.Message) {
+	proto.UnmarshalOptions{Merge: true}.Unmarshal(s.Raw, m)
+}
+
+// parse again, it may be supported now
+func (s *TodoMedia) Reparse() Media {
+	m := &pb.Message{}
+	if len(s.Raw) == 0 || proto.Unmarshal(s.Raw, m) != nil {
+		return s
+	}
+	return (&MessageContent{P: m}).GetMedia()
 }
 
 // text
@@ -229,6 +307,7 @@ func (s *Sticker) ToJson() *ajson.Json {
 	j.Set(`directPath`, s.P.GetDirectPath())
 	j.Set(`fileLength`, s.P.GetFileLength())
 	j.Set(`mediaKeyTimestamp`, s.P.GetMediaKeyTimestamp())
+	set_context_info_json(j, s.P.GetContextInfo())
 	return j
 }
 func (s *Sticker) MsgUrl() string {
@@ -249,6 +328,9 @@ func (s *Sticker) DirectPath() string {
 func (s *Sticker) EncFileHash() []byte {
 	return s.P.GetEncFileHash()
 }
+func (s *Sticker) FileHash() []byte {
+	return s.P.GetFileHash()
+}
 func (s *Sticker) MediaKey() []byte {
 	return s.P.GetMediaKey()
 }
@@ -289,21 +371,33 @@ func (p *Ptt) FillFromJson(j *ajson.Json) error {
 	}
 	p.P.DirectPath = proto.String(j.Get(`directPath`).String())
 	p.P.MediaKeyTimestamp = proto.Uint32(uint32(j.Get(`mediaKeyTimestamp`).Uint64()))
+	if v, e := algo.B64Dec(j.Get(`sidecar`).String()); e == nil && len(v) > 0 {
+		p.P.Sidecar = v
+	}
 
 	return nil
 }
 func (p *Ptt) ToJson() *ajson.Json {
 	j := ajson.New()
-	j.Set(`messageUrl`, p.P.GetMessageUrl())
 	j.Set(`mimeType`, p.P.GetMimeType())
 	j.Set(`fileHash`, algo.B64Enc(p.P.GetFileHash()))
 	j.Set(`fileLength`, p.P.GetFileLength())
 	j.Set(`mediaDuration`, p.P.GetMediaDuration())
 	j.Set(`origin`, p.P.GetOrigin())
-	j.Set(`mediaKey`, algo.B64Enc(p.P.GetMediaKey()))
 	j.Set(`encFileHash`, algo.B64Enc(p.P.GetEncFileHash()))
-	j.Set(`directPath`, p.P.GetDirectPath())
 	j.Set(`mediaKeyTimestamp`, p.P.GetMediaKeyTimestamp())
+	if len(p.P.GetSidecar()) > 0 {
+		j.Set(`sidecar`, algo.B64Enc(p.P.GetSidecar()))
+	}
+	set_context_info_json(j, p.P.GetContextInfo())
+	if p.P.GetViewOnce() {
+		// only downloadable with OpenViewOnce
+		j.Set(`viewOnce`, true)
+	} else {
+		j.Set(`messageUrl`, p.P.GetMessageUrl())
+		j.Set(`mediaKey`, algo.B64Enc(p.P.GetMediaKey()))
+		j.Set(`directPath`, p.P.GetDirectPath())
+	}
 	return j
 }
 func (p *Ptt) MsgUrl() string {
@@ -324,9 +418,15 @@ func (p *Ptt) DirectPath() string {
 func (p *Ptt) EncFileHash() []byte {
 	return p.P.GetEncFileHash()
 }
+func (p *Ptt) FileHash() []byte {
+	return p.P.GetFileHash()
+}
 func (p *Ptt) MediaKey() []byte {
 	return p.P.GetMediaKey()
 }
+func (p *Ptt) Sidecar() []byte {
+	return p.P.GetSidecar()
+}
 func (p *Ptt) CdnHost(cdn *def.Cdn) string {
 	return cdn.Ptt
 }
@@ -378,18 +478,24 @@ func (i *Image) FillFromJson(j *ajson.Json) error {
 }
 func (i *Image) ToJson() *ajson.Json {
 	j := ajson.New()
-	j.Set(`messageUrl`, i.P.GetMessageUrl())
 	j.Set(`mimeType`, i.P.GetMimeType())
 	j.Set(`text`, string(i.P.GetText()))
 	j.Set(`fileHash`, algo.B64Enc(i.P.GetFileHash()))
 	j.Set(`fileLength`, i.P.GetFileLength())
 	j.Set(`height`, i.P.GetHeight())
 	j.Set(`width`, i.P.GetWidth())
-	j.Set(`mediaKey`, algo.B64Enc(i.P.GetMediaKey()))
 	j.Set(`encFileHash`, algo.B64Enc(i.P.GetEncFileHash()))
-	j.Set(`directPath`, i.P.GetDirectPath())
 	j.Set(`mediaKeyTimestamp`, i.P.GetMediaKeyTimestamp())
 	j.Set(`thumbnail`, algo.B64Enc(i.P.GetThumbnail()))
+	set_context_info_json(j, i.P.GetContextInfo())
+	if i.P.GetViewOnce() {
+		// only downloadable with OpenViewOnce
+		j.Set(`viewOnce`, true)
+	} else {
+		j.Set(`messageUrl`, i.P.GetMessageUrl())
+		j.Set(`mediaKey`, algo.B64Enc(i.P.GetMediaKey()))
+		j.Set(`directPath`, i.P.GetDirectPath())
+	}
 	return j
 }
 func (i *Image) MsgUrl() string {
@@ -410,6 +516,9 @@ func (i *Image) DirectPath() string {
 func (i *Image) EncFileHash() []byte {
 	return i.P.GetEncFileHash()
 }
+func (i *Image) FileHash() []byte {
+	return i.P.GetFileHash()
+}
 func (i *Image) MediaKey() []byte {
 	return i.P.GetMediaKey()
 }
@@ -452,6 +561,9 @@ func (v *Video) FillFromJson(j *ajson.Json) error {
 	}
 	v.P.DirectPath = proto.String(j.Get(`directPath`).String())
 	v.P.MediaKeyTimestamp = proto.Uint32(uint32(j.Get(`mediaKeyTimestamp`).Uint64()))
+	if val, e := algo.B64Dec(j.Get(`sidecar`).String()); e == nil && len(val) > 0 {
+		v.P.Sidecar = val
+	}
 
 	if val, e := algo.B64Dec(j.Get(`thumbnail`).String()); e == nil {
 		v.P.Thumbnail = val
@@ -460,7 +572,6 @@ func (v *Video) FillFromJson(j *ajson.Json) error {
 }
 func (v *Video) ToJson() *ajson.Json {
 	j := ajson.New()
-	j.Set(`messageUrl`, v.P.GetMessageUrl())
 	j.Set(`mimeType`, v.P.GetMimeType())
 	j.Set(`text`, string(v.P.GetText()))
 	j.Set(`fileHash`, algo.B64Enc(v.P.GetFileHash()))
@@ -468,11 +579,21 @@ func (v *Video) ToJson() *ajson.Json {
 	j.Set(`fileLength`, v.P.GetFileLength())
 	j.Set(`height`, v.P.GetHeight())
 	j.Set(`width`, v.P.GetWidth())
-	j.Set(`mediaKey`, algo.B64Enc(v.P.GetMediaKey()))
 	j.Set(`encFileHash`, algo.B64Enc(v.P.GetEncFileHash()))
-	j.Set(`directPath`, v.P.GetDirectPath())
 	j.Set(`mediaKeyTimestamp`, v.P.GetMediaKeyTimestamp())
+	if len(v.P.GetSidecar()) > 0 {
+		j.Set(`sidecar`, algo.B64Enc(v.P.GetSidecar()))
+	}
 	j.Set(`thumbnail`, algo.B64Enc(v.P.GetThumbnail()))
+	set_context_info_json(j, v.P.GetContextInfo())
+	if v.P.GetViewOnce() {
+		// only downloadable with OpenViewOnce
+		j.Set(`viewOnce`, true)
+	} else {
+		j.Set(`messageUrl`, v.P.GetMessageUrl())
+		j.Set(`mediaKey`, algo.B64Enc(v.P.GetMediaKey()))
+		j.Set(`directPath`, v.P.GetDirectPath())
+	}
 	return j
 }
 func (v *Video) MsgUrl() string {
@@ -493,9 +614,15 @@ func (v *Video) DirectPath() string {
 func (v *Video) EncFileHash() []byte {
 	return v.P.GetEncFileHash()
 }
+func (v *Video) FileHash() []byte {
+	return v.P.GetFileHash()
+}
 func (v *Video) MediaKey() []byte {
 	return v.P.GetMediaKey()
 }
+func (v *Video) Sidecar() []byte {
+	return v.P.GetSidecar()
+}
 func (s *Video) CdnHost(cdn *def.Cdn) string {
 	return cdn.Video
 }
@@ -596,6 +723,7 @@ func (u *Url) ToJson() *ajson.Json {
 	if u.P.ImgWidth != nil {
 		j.Set(`imgWidth`, u.P.GetImgWidth())
 	}
+	set_context_info_json(j, u.P.GetContextInfo())
 	return j
 }
 func (u *Url) Type() pb.Media_Type {
@@ -654,6 +782,7 @@ func (x *Document) ToJson() *ajson.Json {
 	j.Set(`directPath`, x.P.GetDirectPath())
 	j.Set(`mediaKeyTimestamp`, x.P.GetMediaKeyTimestamp())
 
+	set_context_info_json(j, x.P.GetContextInfo())
 	return j
 }
 func (x *Document) MsgUrl() string {
@@ -674,6 +803,9 @@ func (x *Document) DirectPath() string {
 func (x *Document) EncFileHash() []byte {
 	return x.P.GetMediaEncHash()
 }
+func (x *Document) FileHash() []byte {
+	return x.P.GetMediaHash()
+}
 func (x *Document) MediaKey() []byte {
 	return x.P.GetMediaKey()
 }
@@ -684,6 +816,189 @@ func (x *Document) FillMessage(m *pb.Message) {
 	m.Document = x.P
 }
 
+// ajson has no float getter
+func json_float(j *ajson.Json) (float64, bool) {
+	switch v := j.Data().(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// Location
+type Location struct {
+	NoneCdnMedia
+	NoneEncryptedMedia
+	P *pb.Location
+}
+
+func (l *Location) Serialize() []byte {
+	r, _ := proto.Marshal(l.P)
+	return r
+}
+func (l *Location) DeSerialize(bs []byte) error {
+	l.P = &pb.Location{}
+	return proto.Unmarshal(bs, l.P)
+}
+func (l *Location) FillFromJson(j *ajson.Json) error {
+	lat, ok1 := json_float(j.Get(`degreesLatitude`))
+	long, ok2 := json_float(j.Get(`degreesLongitude`))
+	if !ok1 || !ok2 {
+		return errors.New(`missing 'degreesLatitude' or 'degreesLongitude'`)
+	}
+	l.P.DegreesLatitude = proto.Float64(lat)
+	l.P.DegreesLongitude = proto.Float64(long)
+
+	if v, e := j.Get(`name`).TryString(); e == nil {
+		l.P.Name = proto.String(v)
+	}
+	if v, e := j.Get(`address`).TryString(); e == nil {
+		l.P.Address = proto.String(v)
+	}
+	if v, e := j.Get(`url`).TryString(); e == nil {
+		l.P.Url = proto.String(v)
+	}
+	if v, e := j.Get(`comment`).TryString(); e == nil {
+		l.P.Comment = proto.String(v)
+	}
+	if v, e := j.Get(`accuracyInMeters`).TryInt(); e == nil {
+		l.P.AccuracyInMeters = proto.Uint32(uint32(v))
+	}
+	if v, e := algo.B64Dec(j.Get(`jpegThumbnail`).String()); e == nil && len(v) > 0 {
+		l.P.JpegThumbnail = v
+	}
+	return nil
+}
+func (l *Location) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`degreesLatitude`, l.P.GetDegreesLatitude())
+	j.Set(`degreesLongitude`, l.P.GetDegreesLongitude())
+	if l.P.Name != nil {
+		j.Set(`name`, l.P.GetName())
+	}
+	if l.P.Address != nil {
+		j.Set(`address`, l.P.GetAddress())
+	}
+	if l.P.Url != nil {
+		j.Set(`url`, l.P.GetUrl())
+	}
+	if l.P.Comment != nil {
+		j.Set(`comment`, l.P.GetComment())
+	}
+	if l.P.IsLive != nil {
+		j.Set(`isLive`, l.P.GetIsLive())
+	}
+	if l.P.AccuracyInMeters != nil {
+		j.Set(`accuracyInMeters`, l.P.GetAccuracyInMeters())
+	}
+	if l.P.JpegThumbnail != nil {
+		j.Set(`jpegThumbnail`, algo.B64Enc(l.P.GetJpegThumbnail()))
+	}
+	set_context_info_json(j, l.P.GetContextInfo())
+	return j
+}
+func (l *Location) Type() pb.Media_Type {
+	return pb.Media_Location
+}
+func (l *Location) MsgCategory() string {
+	return `media`
+}
+func (l *Location) FillMessage(m *pb.Message) {
+	m.Location = l.P
+}
+
+/*
+LiveLocation, the first one starts sharing,
+send it again with the new position for each update,
+`sequenceNumber` must increase, defaults to current time in ms
+*/
+type LiveLocation struct {
+	NoneCdnMedia
+	NoneEncryptedMedia
+	P *pb.LiveLocation
+}
+
+func (l *LiveLocation) Serialize() []byte {
+	r, _ := proto.Marshal(l.P)
+	return r
+}
+func (l *LiveLocation) DeSerialize(bs []byte) error {
+	l.P = &pb.LiveLocation{}
+	return proto.Unmarshal(bs, l.P)
+}
+func (l *LiveLocation) FillFromJson(j *ajson.Json) error {
+	lat, ok1 := json_float(j.Get(`degreesLatitude`))
+	long, ok2 := json_float(j.Get(`degreesLongitude`))
+	if !ok1 || !ok2 {
+		return errors.New(`missing 'degreesLatitude' or 'degreesLongitude'`)
+	}
+	l.P.DegreesLatitude = proto.Float64(lat)
+	l.P.DegreesLongitude = proto.Float64(long)
+
+	if v, e := j.Get(`caption`).TryString(); e == nil {
+		l.P.Caption = proto.String(v)
+	}
+	if v, e := j.Get(`accuracyInMeters`).TryInt(); e == nil {
+		l.P.AccuracyInMeters = proto.Uint32(uint32(v))
+	}
+	if v, ok := json_float(j.Get(`speedInMps`)); ok {
+		l.P.SpeedInMps = proto.Float32(float32(v))
+	}
+	if v, e := j.Get(`degreesClockwiseFromMagneticNorth`).TryInt(); e == nil {
+		l.P.DegreesClockwiseFromMagneticNorth = proto.Uint32(uint32(v))
+	}
+	seq, e := j.Get(`sequenceNumber`).TryInt64()
+	if e != nil {
+		seq = time.Now().UnixMilli()
+	}
+	l.P.SequenceNumber = proto.Int64(seq)
+	l.P.TimeOffset = proto.Uint32(uint32(j.Get(`timeOffset`).Uint64()))
+
+	if v, e := algo.B64Dec(j.Get(`jpegThumbnail`).String()); e == nil && len(v) > 0 {
+		l.P.JpegThumbnail = v
+	}
+	return nil
+}
+func (l *LiveLocation) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`degreesLatitude`, l.P.GetDegreesLatitude())
+	j.Set(`degreesLongitude`, l.P.GetDegreesLongitude())
+	if l.P.Caption != nil {
+		j.Set(`caption`, l.P.GetCaption())
+	}
+	if l.P.AccuracyInMeters != nil {
+		j.Set(`accuracyInMeters`, l.P.GetAccuracyInMeters())
+	}
+	if l.P.SpeedInMps != nil {
+		j.Set(`speedInMps`, l.P.GetSpeedInMps())
+	}
+	if l.P.DegreesClockwiseFromMagneticNorth != nil {
+		j.Set(`degreesClockwiseFromMagneticNorth`, l.P.GetDegreesClockwiseFromMagneticNorth())
+	}
+	j.Set(`sequenceNumber`, l.P.GetSequenceNumber())
+	j.Set(`timeOffset`, l.P.GetTimeOffset())
+	if l.P.JpegThumbnail != nil {
+		j.Set(`jpegThumbnail`, algo.B64Enc(l.P.GetJpegThumbnail()))
+	}
+	set_context_info_json(j, l.P.GetContextInfo())
+	return j
+}
+func (l *LiveLocation) Type() pb.Media_Type {
+	return pb.Media_LiveLocation
+}
+func (l *LiveLocation) MsgCategory() string {
+	return `media`
+}
+func (l *LiveLocation) FillMessage(m *pb.Message) {
+	m.LiveLocation = l.P
+}
+
 // Contact
 type Contact struct {
 	NoneCdnMedia
@@ -708,6 +1023,7 @@ func (c *Contact) ToJson() *ajson.Json {
 	j := ajson.New()
 	j.Set(`name`, c.P.GetName())
 	j.Set(`vcard`, c.P.GetVcard())
+	set_context_info_json(j, c.P.GetContextInfo())
 	return j
 }
 func (c *Contact) Type() pb.Media_Type {
@@ -755,6 +1071,7 @@ func (ca *ContactArray) ToJson() *ajson.Json {
 
 		j.Add(`list`, x)
 	}
+	set_context_info_json(j, ca.P.GetContextInfo())
 	return j
 }
 func (ca *ContactArray) Type() pb.Media_Type {
@@ -767,55 +1084,772 @@ func (ca *ContactArray) FillMessage(m *pb.Message) {
 	m.ContactArray = ca.P
 }
 
-func encryptMedia(
-	media_key, salt, raw_file []byte,
-) ([]byte, error) {
-	if len(media_key) != 0x20 {
-		return nil, errors.New(`invalid media key`)
+// MessageKey identifies a message in a chat,
+// used by reaction/quote/revoke...
+func MessageKeyFromJson(j *ajson.Json) *pb.MessageKey {
+	k := &pb.MessageKey{
+		RemoteJid: proto.String(j.Get(`remoteJid`).String()),
+		FromMe:    proto.Bool(j.Get(`fromMe`).Bool()),
+		Id:        proto.String(j.Get(`id`).String()),
+	}
+	if ptcp := j.Get(`participant`).String(); ptcp != `` {
+		k.Participant = proto.String(ptcp)
+	}
+	return k
+}
+func MessageKeyToJson(k *pb.MessageKey) *ajson.Json {
+	j := ajson.New()
+	j.Set(`remoteJid`, k.GetRemoteJid())
+	j.Set(`fromMe`, k.GetFromMe())
+	j.Set(`id`, k.GetId())
+	if k.Participant != nil {
+		j.Set(`participant`, k.GetParticipant())
+	}
+	return j
+}
+
+// Reaction
+type Reaction struct {
+	NoneCdnMedia
+	NoneEncryptedMedia
+	P *pb.Reaction
+}
+
+func (r *Reaction) Serialize() []byte {
+	x, _ := proto.Marshal(r.P)
+	return x
+}
+func (r *Reaction) DeSerialize(bs []byte) error {
+	r.P = &pb.Reaction{}
+	return proto.Unmarshal(bs, r.P)
+}
+func (r *Reaction) FillFromJson(j *ajson.Json) error {
+	kj, ok := j.TryGet(`key`)
+	if !ok {
+		return errors.New(`missing 'key'`)
+	}
+	r.P.Key = MessageKeyFromJson(kj)
+	if r.P.Key.GetId() == `` {
+		return errors.New(`missing 'key.id'`)
 	}
-	x, e := algo.HkdfSha256(
-		media_key,
-		make([]byte, 0x20), // 00000000000000000000000000000000
-		salt,
-		0x50,
-	)
+	// empty text removes the reaction
+	r.P.Text = proto.String(j.Get(`text`).String())
+
+	ts, e := j.Get(`senderTimestampMs`).TryInt64()
 	if e != nil {
-		return nil, e
+		ts = time.Now().UnixMilli()
 	}
-	iv := x[0:0x10]
-	key := x[0x10:0x30]
-	mac_key := x[0x30:]
+	r.P.SenderTimestampMs = proto.Int64(ts)
+	return nil
+}
+func (r *Reaction) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`key`, MessageKeyToJson(r.P.GetKey()).Data())
+	j.Set(`text`, r.P.GetText())
+	j.Set(`senderTimestampMs`, r.P.GetSenderTimestampMs())
+	return j
+}
+func (r *Reaction) Type() pb.Media_Type {
+	return pb.Media_Reaction
+}
+func (r *Reaction) MsgCategory() string {
+	return `reaction`
+}
+func (r *Reaction) FillMessage(m *pb.Message) {
+	m.Reaction = r.P
+}
 
-	enc, e := algo.AesCbcPkcsEncrypt(raw_file, key, iv)
+// PollCreation
+type PollCreation struct {
+	NoneCdnMedia
+	NoneEncryptedMedia
+	P *pb.PollCreation
+}
+
+func (p *PollCreation) Serialize() []byte {
+	x, _ := proto.Marshal(p.P)
+	return x
+}
+func (p *PollCreation) DeSerialize(bs []byte) error {
+	p.P = &pb.PollCreation{}
+	return proto.Unmarshal(bs, p.P)
+}
+
+/*
+	{
+		name: "question",
+		options: ["a", "b", ...],
+		selectableOptionsCount: optional, 0 for unlimited
+	}
+*/
+func (p *PollCreation) FillFromJson(j *ajson.Json) error {
+	name, e := j.Get(`name`).TryString()
 	if e != nil {
-		return nil, e
+		return errors.New(`missing 'name'`)
+	}
+	options, e := j.Get(`options`).TryStringArray()
+	if e != nil || len(options) < 2 {
+		return errors.New(`need at least 2 'options'`)
 	}
-	mac := hmac.New(sha256.New, mac_key)
-	mac.Write(iv)
-	mac.Write(enc)
-	mc := mac.Sum(nil)
+	p.P.Name = proto.String(name)
+	for _, opt := range options {
+		p.P.Options = append(p.P.Options, &pb.PollCreation_Option{
+			OptionName: proto.String(opt),
+		})
+	}
+	p.P.SelectableOptionsCount = proto.Uint32(uint32(j.Get(`selectableOptionsCount`).Uint64()))
 
-	all := append(enc, mc[0:10]...)
-	return all, nil
+	// the message secret, votes are encrypted with it
+	p.P.EncKey = arand.Bytes(32)
+	return nil
 }
-func decryptMedia(m Media, bs []byte) ([]byte, error) {
-	if len(bs) < 10 {
-		return nil, errors.New(`data too short`)
+func (p *PollCreation) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`name`, p.P.GetName())
+	options := []string{}
+	for _, opt := range p.P.GetOptions() {
+		options = append(options, opt.GetOptionName())
 	}
+	j.Set(`options`, options)
+	j.Set(`selectableOptionsCount`, p.P.GetSelectableOptionsCount())
+	set_context_info_json(j, p.P.GetContextInfo())
+	return j
+}
+func (p *PollCreation) Type() pb.Media_Type {
+	return pb.Media_Poll_Creation
+}
+func (p *PollCreation) MsgCategory() string {
+	return `poll`
+}
+
+// the secret goes to MessageContextInfo rather than `encKey`
+func (p *PollCreation) FillMessage(m *pb.Message) {
+	pc := proto.Clone(p.P).(*pb.PollCreation)
+	pc.EncKey = nil
+	m.PollCreation = pc
+	m.MessageContextInfo = &pb.MessageContextInfo{
+		MessageSecret: p.P.GetEncKey(),
+	}
+}
 
-	x, e := algo.HkdfSha256(
-		m.MediaKey(),
-		make([]byte, 0x20), // 00000000000000000000000000000000
-		m.SaltString(), 0x30,
-	)
+// PollUpdate, a vote, only for receiving
+type PollUpdate struct {
+	NoneCdnMedia
+	NoneEncryptedMedia
+	P *pb.PollUpdate
+}
+
+func (p *PollUpdate) Serialize() []byte {
+	x, _ := proto.Marshal(p.P)
+	return x
+}
+func (p *PollUpdate) DeSerialize(bs []byte) error {
+	p.P = &pb.PollUpdate{}
+	return proto.Unmarshal(bs, p.P)
+}
+func (p *PollUpdate) FillFromJson(j *ajson.Json) error {
+	return errors.New(`sending poll vote is not supported`)
+}
+func (p *PollUpdate) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`pollCreationMessageKey`, MessageKeyToJson(p.P.GetPollCreationMessageKey()).Data())
+	j.Set(`senderTimestampMs`, p.P.GetSenderTimestampMs())
+	return j
+}
+func (p *PollUpdate) Type() pb.Media_Type {
+	return pb.Media_Poll_Update
+}
+func (p *PollUpdate) MsgCategory() string {
+	return `poll`
+}
+func (p *PollUpdate) FillMessage(m *pb.Message) {
+	m.PollUpdate = p.P
+}
+
+// Buttons, reply buttons of business account
+type Buttons struct {
+	NoneCdnMedia
+	NoneEncryptedMedia
+	P *pb.ButtonsMessage
+}
+
+func (b *Buttons) Serialize() []byte {
+	x, _ := proto.Marshal(b.P)
+	return x
+}
+func (b *Buttons) DeSerialize(bs []byte) error {
+	b.P = &pb.ButtonsMessage{}
+	return proto.Unmarshal(bs, b.P)
+}
+
+/*
+	{
+		header: optional,
+		text: "content",
+		footer: optional,
+		buttons: [{id, text}, ...]  // at most 3
+	}
+*/
+func (b *Buttons) FillFromJson(j *ajson.Json) error {
+	text, e := j.Get(`text`).TryString()
 	if e != nil {
-		return nil, e
+		return errors.New(`missing 'text'`)
+	}
+	buttons, e := j.Get(`buttons`).TryJsonArray()
+	if e != nil || len(buttons) == 0 || len(buttons) > 3 {
+		return errors.New(`need 1~3 'buttons'`)
+	}
+	b.P.ContentText = proto.String(text)
+	if v, e := j.Get(`footer`).TryString(); e == nil {
+		b.P.FooterText = proto.String(v)
+	}
+	if v, e := j.Get(`header`).TryString(); e == nil {
+		b.P.Text = proto.String(v)
+		b.P.HeaderType = pb.ButtonsMessage_TEXT.Enum()
+	} else {
+		b.P.HeaderType = pb.ButtonsMessage_EMPTY.Enum()
+	}
+	for _, bj := range buttons {
+		id, e := bj.Get(`id`).TryString()
+		if e != nil {
+			return errors.New(`missing 'buttons.id'`)
+		}
+		b.P.Buttons = append(b.P.Buttons, &pb.ButtonsMessage_Button{
+			ButtonId: proto.String(id),
+			ButtonText: &pb.ButtonsMessage_Button_ButtonText{
+				DisplayText: proto.String(bj.Get(`text`).String()),
+			},
+			Type: pb.ButtonsMessage_Button_RESPONSE.Enum(),
+		})
+	}
+	return nil
+}
+func (b *Buttons) ToJson() *ajson.Json {
+	j := ajson.New()
+	if b.P.Text != nil {
+		j.Set(`header`, b.P.GetText())
 	}
-	iv := x[:0x10]
-	key := x[0x10:]
+	j.Set(`text`, b.P.GetContentText())
+	if b.P.FooterText != nil {
+		j.Set(`footer`, b.P.GetFooterText())
+	}
+	for _, btn := range b.P.GetButtons() {
+		bj := ajson.New()
+		bj.Set(`id`, btn.GetButtonId())
+		bj.Set(`text`, btn.GetButtonText().GetDisplayText())
+		j.Add(`buttons`, bj)
+	}
+	set_context_info_json(j, b.P.GetContextInfo())
+	return j
+}
+func (b *Buttons) Type() pb.Media_Type {
+	return pb.Media_Buttons
+}
+func (b *Buttons) MsgCategory() string {
+	return `text`
+}
+func (b *Buttons) FillMessage(m *pb.Message) {
+	m.Buttons = b.P
+}
+
+// ButtonsResponse, a button is tapped, only for receiving
+type ButtonsResponse struct {
+	NoneCdnMedia
+	NoneEncryptedMedia
+	P *pb.ButtonsResponseMessage
+}
+
+func (b *ButtonsResponse) Serialize() []byte {
+	x, _ := proto.Marshal(b.P)
+	return x
+}
+func (b *ButtonsResponse) DeSerialize(bs []byte) error {
+	b.P = &pb.ButtonsResponseMessage{}
+	return proto.Unmarshal(bs, b.P)
+}
+func (b *ButtonsResponse) FillFromJson(j *ajson.Json) error {
+	return errors.New(`sending buttons response is not supported`)
+}
+func (b *ButtonsResponse) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`selectedButtonId`, b.P.GetSelectedButtonId())
+	j.Set(`selectedDisplayText`, b.P.GetSelectedDisplayText())
+	set_context_info_json(j, b.P.GetContextInfo())
+	return j
+}
+func (b *ButtonsResponse) Type() pb.Media_Type {
+	return pb.Media_Buttons_Response
+}
+func (b *ButtonsResponse) MsgCategory() string {
+	return `text`
+}
+func (b *ButtonsResponse) FillMessage(m *pb.Message) {
+	m.ButtonsResponse = b.P
+}
 
-	cipher := bs[:len(bs)-10] // except last 10 bytes Mac
+// List, a button that opens sections of rows
+type List struct {
+	NoneCdnMedia
+	NoneEncryptedMedia
+	P *pb.ListMessage
+}
 
-	//return algo.AesCbcDecrypt(cipher, key, iv, &algo.None{})
-	return algo.AesCbcPkcsDecrypt(cipher, key, iv)
+func (l *List) Serialize() []byte {
+	x, _ := proto.Marshal(l.P)
+	return x
+}
+func (l *List) DeSerialize(bs []byte) error {
+	l.P = &pb.ListMessage{}
+	return proto.Unmarshal(bs, l.P)
+}
+
+/*
+	{
+		title: optional,
+		description: "content",
+		buttonText: "text of the button that opens the list",
+		footer: optional,
+		sections: [{
+			title,
+			rows: [{id, title, description}, ...]
+		}, ...]
+	}
+*/
+func (l *List) FillFromJson(j *ajson.Json) error {
+	button_text, e := j.Get(`buttonText`).TryString()
+	if e != nil {
+		return errors.New(`missing 'buttonText'`)
+	}
+	sections, e := j.Get(`sections`).TryJsonArray()
+	if e != nil || len(sections) == 0 {
+		return errors.New(`missing 'sections'`)
+	}
+	l.P.Title = proto.String(j.Get(`title`).String())
+	l.P.Description = proto.String(j.Get(`description`).String())
+	l.P.ButtonText = proto.String(button_text)
+	l.P.ListType = pb.ListMessage_SINGLE_SELECT.Enum()
+	if v, e := j.Get(`footer`).TryString(); e == nil {
+		l.P.FooterText = proto.String(v)
+	}
+	for _, sj := range sections {
+		rows, e := sj.Get(`rows`).TryJsonArray()
+		if e != nil || len(rows) == 0 {
+			return errors.New(`missing 'sections.rows'`)
+		}
+		sec := &pb.ListMessage_Section{
+			Title: proto.String(sj.Get(`title`).String()),
+		}
+		for _, rj := range rows {
+			id, e := rj.Get(`id`).TryString()
+			if e != nil {
+				return errors.New(`missing 'rows.id'`)
+			}
+			sec.Rows = append(sec.Rows, &pb.ListMessage_Row{
+				RowId:       proto.String(id),
+				Title:       proto.String(rj.Get(`title`).String()),
+				Description: proto.String(rj.Get(`description`).String()),
+			})
+		}
+		l.P.Sections = append(l.P.Sections, sec)
+	}
+	return nil
+}
+func (l *List) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`title`, l.P.GetTitle())
+	j.Set(`description`, l.P.GetDescription())
+	j.Set(`buttonText`, l.P.GetButtonText())
+	if l.P.FooterText != nil {
+		j.Set(`footer`, l.P.GetFooterText())
+	}
+	for _, sec := range l.P.GetSections() {
+		sj := ajson.New()
+		sj.Set(`title`, sec.GetTitle())
+		for _, row := range sec.GetRows() {
+			rj := ajson.New()
+			rj.Set(`id`, row.GetRowId())
+			rj.Set(`title`, row.GetTitle())
+			rj.Set(`description`, row.GetDescription())
+			sj.Add(`rows`, rj)
+		}
+		j.Add(`sections`, sj)
+	}
+	set_context_info_json(j, l.P.GetContextInfo())
+	return j
+}
+func (l *List) Type() pb.Media_Type {
+	return pb.Media_List
+}
+func (l *List) MsgCategory() string {
+	return `text`
+}
+func (l *List) FillMessage(m *pb.Message) {
+	m.List = l.P
+}
+
+// ListResponse, a row is selected, only for receiving
+type ListResponse struct {
+	NoneCdnMedia
+	NoneEncryptedMedia
+	P *pb.ListResponseMessage
+}
+
+func (l *ListResponse) Serialize() []byte {
+	x, _ := proto.Marshal(l.P)
+	return x
+}
+func (l *ListResponse) DeSerialize(bs []byte) error {
+	l.P = &pb.ListResponseMessage{}
+	return proto.Unmarshal(bs, l.P)
+}
+func (l *ListResponse) FillFromJson(j *ajson.Json) error {
+	return errors.New(`sending list response is not supported`)
+}
+func (l *ListResponse) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`title`, l.P.GetTitle())
+	j.Set(`description`, l.P.GetDescription())
+	j.Set(`selectedRowId`, l.P.GetSingleSelectReply().GetSelectedRowId())
+	set_context_info_json(j, l.P.GetContextInfo())
+	return j
+}
+func (l *ListResponse) Type() pb.Media_Type {
+	return pb.Media_List_Response
+}
+func (l *ListResponse) MsgCategory() string {
+	return `text`
+}
+func (l *ListResponse) FillMessage(m *pb.Message) {
+	m.ListResponse = l.P
+}
+
+// Template, with quick reply/url/call buttons
+type Template struct {
+	NoneCdnMedia
+	NoneEncryptedMedia
+	P *pb.TemplateMessage
+}
+
+func (t *Template) Serialize() []byte {
+	x, _ := proto.Marshal(t.P)
+	return x
+}
+func (t *Template) DeSerialize(bs []byte) error {
+	t.P = &pb.TemplateMessage{}
+	return proto.Unmarshal(bs, t.P)
+}
+
+/*
+	{
+		title: optional,
+		text: "content",
+		footer: optional,
+		templateId: optional,
+		buttons: [
+			{type: "quick_reply", text, id},
+			{type: "url", text, url},
+			{type: "call", text, phone},
+		]
+	}
+*/
+func (t *Template) FillFromJson(j *ajson.Json) error {
+	text, e := j.Get(`text`).TryString()
+	if e != nil {
+		return errors.New(`missing 'text'`)
+	}
+	buttons, e := j.Get(`buttons`).TryJsonArray()
+	if e != nil || len(buttons) == 0 {
+		return errors.New(`missing 'buttons'`)
+	}
+	ht := &pb.HydratedFourRowTemplate{
+		HydratedContentText: proto.String(text),
+	}
+	if v, e := j.Get(`title`).TryString(); e == nil {
+		ht.HydratedTitleText = proto.String(v)
+	}
+	if v, e := j.Get(`footer`).TryString(); e == nil {
+		ht.HydratedFooterText = proto.String(v)
+	}
+	if v, e := j.Get(`templateId`).TryString(); e == nil {
+		ht.TemplateId = proto.String(v)
+	}
+	for i, bj := range buttons {
+		btn := &pb.HydratedTemplateButton{
+			Index: proto.Uint32(uint32(i)),
+		}
+		display := proto.String(bj.Get(`text`).String())
+
+		switch bj.Get(`type`).String() {
+		case `quick_reply`:
+			btn.QuickReplyButton = &pb.HydratedTemplateButton_QuickReplyButton{
+				DisplayText: display,
+				Id:          proto.String(bj.Get(`id`).String()),
+			}
+		case `url`:
+			btn.UrlButton = &pb.HydratedTemplateButton_UrlButton{
+				DisplayText: display,
+				Url:         proto.String(bj.Get(`url`).String()),
+			}
+		case `call`:
+			btn.CallButton = &pb.HydratedTemplateButton_CallButton{
+				DisplayText: display,
+				PhoneNumber: proto.String(bj.Get(`phone`).String()),
+			}
+		default:
+			return errors.New(`unsupported template button type: ` + bj.Get(`type`).String())
+		}
+		ht.HydratedButtons = append(ht.HydratedButtons, btn)
+	}
+	t.P.HydratedTemplate = ht
+	return nil
+}
+func (t *Template) ToJson() *ajson.Json {
+	ht := t.P.GetHydratedTemplate()
+
+	j := ajson.New()
+	if ht.HydratedTitleText != nil {
+		j.Set(`title`, ht.GetHydratedTitleText())
+	}
+	j.Set(`text`, ht.GetHydratedContentText())
+	if ht.HydratedFooterText != nil {
+		j.Set(`footer`, ht.GetHydratedFooterText())
+	}
+	if ht.TemplateId != nil {
+		j.Set(`templateId`, ht.GetTemplateId())
+	}
+	for _, btn := range ht.GetHydratedButtons() {
+		bj := ajson.New()
+		switch {
+		case btn.QuickReplyButton != nil:
+			bj.Set(`type`, `quick_reply`)
+			bj.Set(`text`, btn.QuickReplyButton.GetDisplayText())
+			bj.Set(`id`, btn.QuickReplyButton.GetId())
+		case btn.UrlButton != nil:
+			bj.Set(`type`, `url`)
+			bj.Set(`text`, btn.UrlButton.GetDisplayText())
+			bj.Set(`url`, btn.UrlButton.GetUrl())
+		case btn.CallButton != nil:
+			bj.Set(`type`, `call`)
+			bj.Set(`text`, btn.CallButton.GetDisplayText())
+			bj.Set(`phone`, btn.CallButton.GetPhoneNumber())
+		}
+		j.Add(`buttons`, bj)
+	}
+	set_context_info_json(j, t.P.GetContextInfo())
+	return j
+}
+func (t *Template) Type() pb.Media_Type {
+	return pb.Media_Template
+}
+func (t *Template) MsgCategory() string {
+	return `text`
+}
+func (t *Template) FillMessage(m *pb.Message) {
+	m.Template = t.P
+}
+
+// TemplateReply, a quick reply button is tapped, only for receiving
+type TemplateReply struct {
+	NoneCdnMedia
+	NoneEncryptedMedia
+	P *pb.TemplateButtonReplyMessage
+}
+
+func (t *TemplateReply) Serialize() []byte {
+	x, _ := proto.Marshal(t.P)
+	return x
+}
+func (t *TemplateReply) DeSerialize(bs []byte) error {
+	t.P = &pb.TemplateButtonReplyMessage{}
+	return proto.Unmarshal(bs, t.P)
+}
+func (t *TemplateReply) FillFromJson(j *ajson.Json) error {
+	return errors.New(`sending template reply is not supported`)
+}
+func (t *TemplateReply) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`selectedId`, t.P.GetSelectedId())
+	j.Set(`selectedDisplayText`, t.P.GetSelectedDisplayText())
+	j.Set(`selectedIndex`, t.P.GetSelectedIndex())
+	set_context_info_json(j, t.P.GetContextInfo())
+	return j
+}
+func (t *TemplateReply) Type() pb.Media_Type {
+	return pb.Media_Template_Reply
+}
+func (t *TemplateReply) MsgCategory() string {
+	return `text`
+}
+func (t *TemplateReply) FillMessage(m *pb.Message) {
+	m.TemplateButtonReply = t.P
+}
+
+// Protocol, revoke/edit of a sent message
+type Protocol struct {
+	NoneCdnMedia
+	NoneEncryptedMedia
+	P *pb.ProtocolMessage
+
+	// the `edit` attr of <message>, only for sending
+	// 1: edit, 7: revoke, 8: revoked by group admin
+	Edit string
+}
+
+func protocol_type_int(t string) (pb.ProtocolMessage_Type, bool) {
+	switch t {
+	case `revoke`:
+		return pb.ProtocolMessage_REVOKE, true
+	case `edit`:
+		return pb.ProtocolMessage_MESSAGE_EDIT, true
+	case `ephemeral_setting`:
+		return pb.ProtocolMessage_EPHEMERAL_SETTING, true
+	}
+	return 0, false
+}
+func protocol_type_str(t pb.ProtocolMessage_Type) string {
+	switch t {
+	case pb.ProtocolMessage_REVOKE:
+		return `revoke`
+	case pb.ProtocolMessage_MESSAGE_EDIT:
+		return `edit`
+	case pb.ProtocolMessage_EPHEMERAL_SETTING:
+		return `ephemeral_setting`
+	}
+	return strconv.Itoa(int(t))
+}
+
+func (p *Protocol) Serialize() []byte {
+	x, _ := proto.Marshal(p.P)
+	return x
+}
+func (p *Protocol) DeSerialize(bs []byte) error {
+	p.P = &pb.ProtocolMessage{}
+	return proto.Unmarshal(bs, p.P)
+}
+
+/*
+	{
+		key: {remoteJid, fromMe, id, participant}, // not for `ephemeral_setting`
+		type: `revoke`/`edit`/`ephemeral_setting`,
+		editedMessage: { media_type, media }, // for `edit`
+		ephemeralExpiration: seconds, // for `ephemeral_setting`
+		timestampMs: optional, default now
+	}
+*/
+func (p *Protocol) FillFromJson(j *ajson.Json) error {
+	t, ok := protocol_type_int(j.Get(`type`).String())
+	if !ok {
+		return errors.New(`unsupported protocol type`)
+	}
+	p.P.Type = t.Enum()
+
+	if t != pb.ProtocolMessage_EPHEMERAL_SETTING {
+		kj, ok := j.TryGet(`key`)
+		if !ok {
+			return errors.New(`missing 'key'`)
+		}
+		p.P.Key = MessageKeyFromJson(kj)
+		if p.P.Key.GetId() == `` {
+			return errors.New(`missing 'key.id'`)
+		}
+	}
+
+	switch t {
+	case pb.ProtocolMessage_EPHEMERAL_SETTING:
+		p.P.EphemeralExpiration = proto.Uint32(uint32(j.Get(`ephemeralExpiration`).Uint64()))
+		p.P.EphemeralSettingTimestamp = proto.Int64(time.Now().Unix())
+	case pb.ProtocolMessage_REVOKE:
+		p.Edit = `7`
+		// revoke others' message as group admin
+		if !p.P.Key.GetFromMe() && p.P.Key.Participant != nil {
+			p.Edit = `8`
+		}
+	case pb.ProtocolMessage_MESSAGE_EDIT:
+		p.Edit = `1`
+
+		ej, ok := j.TryGet(`editedMessage`)
+		if !ok {
+			return errors.New(`missing 'editedMessage'`)
+		}
+		edited, e := NewMedia(MediaTypeInt(ej.Get(`media_type`).String()))
+		if e != nil {
+			return e
+		}
+		mj, ok := ej.TryGet(`media`)
+		if !ok {
+			return errors.New(`missing 'editedMessage.media'`)
+		}
+		if e := edited.FillFromJson(mj); e != nil {
+			return e
+		}
+		p.P.EditedMessage = &pb.Message{}
+		edited.FillMessage(p.P.EditedMessage)
+	}
+	ts, e := j.Get(`timestampMs`).TryInt64()
+	if e != nil {
+		ts = time.Now().UnixMilli()
+	}
+	p.P.TimestampMs = proto.Int64(ts)
+	return nil
+}
+func (p *Protocol) ToJson() *ajson.Json {
+	j := ajson.New()
+	if p.P.Key != nil {
+		j.Set(`key`, MessageKeyToJson(p.P.GetKey()).Data())
+	}
+	j.Set(`type`, protocol_type_str(p.P.GetType()))
+	if p.P.EphemeralExpiration != nil {
+		j.Set(`ephemeralExpiration`, p.P.GetEphemeralExpiration())
+	}
+	if p.P.EditedMessage != nil {
+		edited := p.EditedMedia()
+
+		ej := ajson.New()
+		ej.Set(`media_type`, MediaTypeStr(edited.Type()))
+		ej.Set(`media`, edited.ToJson().Data())
+		j.Set(`editedMessage`, ej.Data())
+	}
+	if p.P.TimestampMs != nil {
+		j.Set(`timestampMs`, p.P.GetTimestampMs())
+	}
+	return j
+}
+func (p *Protocol) EditedMedia() Media {
+	if p.P.EditedMessage == nil {
+		return &TodoMedia{}
+	}
+	return (&MessageContent{P: p.P.GetEditedMessage()}).GetMedia()
+}
+func (p *Protocol) Type() pb.Media_Type {
+	return pb.Media_Protocol
+}
+func (p *Protocol) MsgCategory() string {
+	return `text`
+}
+func (p *Protocol) FillMessage(m *pb.Message) {
+	m.Protocol = p.P
+}
+
+// typed errors of media download, callers can tell them by `ErrCode`
+type ErrMedia int
+
+const (
+	ErrMediaTruncated ErrMedia = 3424 + iota
+	ErrMediaMacMismatch
+	ErrMediaHashMismatch
+)
+
+func (e ErrMedia) Error() string {
+	switch e {
+	case ErrMediaTruncated:
+		return `media truncated`
+	case ErrMediaMacMismatch:
+		return `media mac mismatch`
+	case ErrMediaHashMismatch:
+		return `media hash mismatch`
+	}
+	return `media error`
+}
+func (e ErrMedia) ErrCode() int {
+	return int(e)
 }
@@ -0,0 +1,321 @@
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"io"
+
+	_ "image/gif"
+	_ "image/png"
+
+	"ajson"
+	"algo"
+	"wa/pb"
+
+	"github.com/pkg/errors"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// max width/height of the thumbnail
+const ThumbnailSize = 100
+
+// larger images are not decoded, to avoid decompression bomb
+const MaxImagePixels = 50_000_000
+
+// metadata derived from the raw file
+type media_meta struct {
+	MimeType  string
+	Width     int
+	Height    int
+	Duration  int // in seconds
+	Thumbnail []byte
+}
+
+/*
+JFIF APP0 segment, inserted after SOI,
+the jpeg from golang doesn't have it, but WhatsApp requires it.
+
+	FF E0 | 00 10 | "JFIF\0" | 01 01 | 00 | 00 01 | 00 01 | 00 00
+*/
+var jfif_app0 = []byte{
+	0xff, 0xe0, 0x00, 0x10,
+	'J', 'F', 'I', 'F', 0x00,
+	0x01, 0x01, // version 1.1
+	0x00,       // no units
+	0x00, 0x01, // x density
+	0x00, 0x01, // y density
+	0x00, 0x00, // no embedded thumbnail
+}
+
+func to_jfif(jpg []byte) []byte {
+	if len(jpg) < 4 || jpg[0] != 0xff || jpg[1] != 0xd8 { // not jpeg
+		return jpg
+	}
+	if jpg[2] == 0xff && jpg[3] == 0xe0 { // already has APP0
+		return jpg
+	}
+	ret := make([]byte, 0, len(jpg)+len(jfif_app0))
+	ret = append(ret, jpg[:2]...)
+	ret = append(ret, jfif_app0...)
+	ret = append(ret, jpg[2:]...)
+	return ret
+}
+
+// scale down to fit ThumbnailSize, keep the ratio
+func jfif_thumbnail(img image.Image) ([]byte, error) {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w == 0 || h == 0 {
+		return nil, errors.New(`empty image`)
+	}
+	if w > ThumbnailSize || h > ThumbnailSize {
+		if w > h {
+			w, h = ThumbnailSize, h*ThumbnailSize/w
+		} else {
+			w, h = w*ThumbnailSize/h, ThumbnailSize
+		}
+		if w == 0 {
+			w = 1
+		}
+		if h == 0 {
+			h = 1
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Src, nil)
+
+	var buf bytes.Buffer
+	if e := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80}); e != nil {
+		return nil, e
+	}
+	return to_jfif(buf.Bytes()), nil
+}
+
+func image_meta(r io.ReadSeeker) (*media_meta, error) {
+	cfg, _, e := image.DecodeConfig(r)
+	if e != nil {
+		return nil, errors.Wrap(e, `fail decode image`)
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > MaxImagePixels {
+		return nil, errors.Errorf(`image too large: %dx%d`, cfg.Width, cfg.Height)
+	}
+	if _, e := r.Seek(0, io.SeekStart); e != nil {
+		return nil, e
+	}
+
+	img, format, e := image.Decode(r)
+	if e != nil {
+		return nil, errors.Wrap(e, `fail decode image`)
+	}
+	thumb, e := jfif_thumbnail(img)
+	if e != nil {
+		return nil, e
+	}
+	b := img.Bounds()
+	return &media_meta{
+		MimeType:  `image/` + format,
+		Width:     b.Dx(),
+		Height:    b.Dy(),
+		Thumbnail: thumb,
+	}, nil
+}
+
+// read `n` bytes at `off`
+func read_at(r io.ReadSeeker, off int64, n int) ([]byte, error) {
+	if _, e := r.Seek(off, io.SeekStart); e != nil {
+		return nil, e
+	}
+	bs := make([]byte, n)
+	if _, e := io.ReadFull(r, bs); e != nil {
+		return nil, e
+	}
+	return bs, nil
+}
+
+// iterate mp4 boxes in range [start, end),
+// `fn` receives the box type and its payload range
+func mp4_walk(
+	r io.ReadSeeker,
+	start, end int64,
+	fn func(typ string, off, size int64) error,
+) error {
+	for pos := start; pos+8 <= end; {
+		hdr, e := read_at(r, pos, 8)
+		if e != nil {
+			return e
+		}
+		size := int64(binary.BigEndian.Uint32(hdr))
+		typ := string(hdr[4:8])
+		hdr_len := int64(8)
+
+		switch size {
+		case 0: // to the end
+			size = end - pos
+		case 1: // 64bit size
+			x, e := read_at(r, pos+8, 8)
+			if e != nil {
+				return e
+			}
+			size = int64(binary.BigEndian.Uint64(x))
+			hdr_len = 16
+		}
+		if size < hdr_len || pos+size > end {
+			return errors.New(`invalid mp4 box: ` + typ)
+		}
+
+		if e := fn(typ, pos+hdr_len, size-hdr_len); e != nil {
+			return e
+		}
+		pos += size
+	}
+	return nil
+}
+
+/*
+Duration from `moov.mvhd`,
+width/height from `moov.trak.tkhd` of the first video track.
+
+	mvhd: version(1) flags(3) | v0: ctime(4) mtime(4) timescale(4) duration(4)
+	                          | v1: ctime(8) mtime(8) timescale(4) duration(8)
+	tkhd: version(1) flags(3) | v0: 20 bytes | v1: 32 bytes
+	      | reserved(8) layer(2) group(2) volume(2) reserved(2)
+	      | matrix(36) | width(4, 16.16) | height(4, 16.16)
+*/
+func mp4_meta(r io.ReadSeeker) (*media_meta, error) {
+	end, e := r.Seek(0, io.SeekEnd)
+	if e != nil {
+		return nil, e
+	}
+	if hdr, e := read_at(r, 0, 8); e != nil || string(hdr[4:8]) != `ftyp` {
+		return nil, errors.New(`not mp4`)
+	}
+
+	ret := &media_meta{MimeType: `video/mp4`}
+
+	mvhd := func(off, size int64) error {
+		bs, e := read_at(r, off, int(min64(size, 32)))
+		if e != nil {
+			return e
+		}
+		if len(bs) < 4 { // no version/flags
+			return nil
+		}
+		var timescale, duration uint64
+		if bs[0] == 1 && len(bs) >= 32 {
+			timescale = uint64(binary.BigEndian.Uint32(bs[20:]))
+			duration = binary.BigEndian.Uint64(bs[24:])
+		} else if len(bs) >= 20 {
+			timescale = uint64(binary.BigEndian.Uint32(bs[12:]))
+			duration = uint64(binary.BigEndian.Uint32(bs[16:]))
+		}
+		if timescale > 0 {
+			ret.Duration = int((duration + timescale - 1) / timescale)
+		}
+		return nil
+	}
+	tkhd := func(off, size int64) error {
+		// v1: 4 + 32 + 16 + 36 + 8
+		bs, e := read_at(r, off, int(min64(size, 96)))
+		if e != nil {
+			return e
+		}
+		if len(bs) < 4 { // no version/flags
+			return nil
+		}
+		pos := 4 + 20
+		if bs[0] == 1 && len(bs) >= 32 {
+			pos = 4 + 32
+		}
+		pos += 16 // reserved/layer/group/volume/reserved
+		if len(bs) < pos+36+8 {
+			return nil
+		}
+		matrix := bs[pos : pos+36]
+		w := int(binary.BigEndian.Uint32(bs[pos+36:]) >> 16)
+		h := int(binary.BigEndian.Uint32(bs[pos+40:]) >> 16)
+		if w == 0 || h == 0 { // audio track
+			return nil
+		}
+		// rotated 90/270 degree, a == 0 && d == 0
+		if binary.BigEndian.Uint32(matrix[0:]) == 0 && binary.BigEndian.Uint32(matrix[16:]) == 0 {
+			w, h = h, w
+		}
+		ret.Width, ret.Height = w, h
+		return nil
+	}
+
+	e = mp4_walk(r, 0, end, func(typ string, off, size int64) error {
+		if typ != `moov` {
+			return nil
+		}
+		return mp4_walk(r, off, off+size, func(typ string, off, size int64) error {
+			switch typ {
+			case `mvhd`:
+				return mvhd(off, size)
+			case `trak`:
+				if ret.Width > 0 { // already found
+					return nil
+				}
+				return mp4_walk(r, off, off+size, func(typ string, off, size int64) error {
+					if typ == `tkhd` {
+						return tkhd(off, size)
+					}
+					return nil
+				})
+			}
+			return nil
+		})
+	})
+	if e != nil {
+		return nil, e
+	}
+	return ret, nil
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+/*
+Fill missing fields of outgoing image/video from the raw file:
+mimeType, width, height, thumbnail (image) and mediaDuration (video).
+Fields given by caller are kept.
+*/
+func fill_media_meta(media_t pb.Media_Type, r io.ReadSeeker, j *ajson.Json) error {
+	var meta *media_meta
+	var e error
+
+	switch media_t {
+	case pb.Media_Image:
+		meta, e = image_meta(r)
+	case pb.Media_Video:
+		meta, e = mp4_meta(r)
+	default:
+		return nil
+	}
+	if e != nil {
+		return e
+	}
+
+	if len(j.Get(`mimeType`).String()) == 0 {
+		j.Set(`mimeType`, meta.MimeType)
+	}
+	if j.Get(`width`).Uint64() == 0 || j.Get(`height`).Uint64() == 0 {
+		j.Set(`width`, meta.Width)
+		j.Set(`height`, meta.Height)
+	}
+	if len(j.Get(`thumbnail`).String()) == 0 && len(meta.Thumbnail) > 0 {
+		j.Set(`thumbnail`, algo.B64Enc(meta.Thumbnail))
+	}
+	if media_t == pb.Media_Video && j.Get(`mediaDuration`).Uint64() == 0 {
+		j.Set(`mediaDuration`, meta.Duration)
+	}
+	return nil
+}
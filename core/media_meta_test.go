@@ -0,0 +1,72 @@
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func mp4_box(typ string, payload ...[]byte) []byte {
+	body := bytes.Join(payload, nil)
+	bs := make([]byte, 8, 8+len(body))
+	binary.BigEndian.PutUint32(bs, uint32(8+len(body)))
+	copy(bs[4:], typ)
+	return append(bs, body...)
+}
+
+func mp4_file(mvhd, tkhd []byte) []byte {
+	return append(
+		mp4_box(`ftyp`, []byte(`isom`)),
+		mp4_box(`moov`,
+			mp4_box(`mvhd`, mvhd),
+			mp4_box(`trak`, mp4_box(`tkhd`, tkhd)),
+		)...,
+	)
+}
+
+func TestMp4Meta(t *testing.T) {
+	// v0, timescale 1000, duration 2500
+	mvhd := make([]byte, 20)
+	binary.BigEndian.PutUint32(mvhd[12:], 1000)
+	binary.BigEndian.PutUint32(mvhd[16:], 2500)
+	// v0, identity matrix, 640x480
+	tkhd := make([]byte, 4+20+16+36+8)
+	binary.BigEndian.PutUint32(tkhd[40:], 0x10000)
+	binary.BigEndian.PutUint32(tkhd[56:], 0x10000)
+	binary.BigEndian.PutUint32(tkhd[76:], 640<<16)
+	binary.BigEndian.PutUint32(tkhd[80:], 480<<16)
+
+	meta, e := mp4_meta(bytes.NewReader(mp4_file(mvhd, tkhd)))
+	if e != nil {
+		t.Fatal(e)
+	}
+	if meta.Duration != 3 || meta.Width != 640 || meta.Height != 480 {
+		t.Fatalf("got %ds %dx%d", meta.Duration, meta.Width, meta.Height)
+	}
+}
+
+func TestMp4MetaTruncated(t *testing.T) {
+	v1 := func(n int) []byte {
+		bs := make([]byte, n)
+		bs[0] = 1
+		return bs
+	}
+	for _, c := range []struct {
+		name       string
+		mvhd, tkhd []byte
+	}{
+		{`empty`, nil, nil},
+		{`no flags`, []byte{0, 0}, []byte{1, 0, 0}},
+		{`short v0`, make([]byte, 12), make([]byte, 40)},
+		{`short v1`, v1(28), v1(28)},
+		{`v1 without matrix`, v1(32), v1(60)},
+	} {
+		meta, e := mp4_meta(bytes.NewReader(mp4_file(c.mvhd, c.tkhd)))
+		if e != nil {
+			t.Fatalf("%s: %v", c.name, e)
+		}
+		if meta.Duration != 0 || meta.Width != 0 || meta.Height != 0 {
+			t.Fatalf("%s: got %ds %dx%d", c.name, meta.Duration, meta.Width, meta.Height)
+		}
+	}
+}
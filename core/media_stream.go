@@ -0,0 +1,444 @@
+package core
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"hash"
+	"io"
+
+	"algo"
+	"wa/pb"
+
+	"github.com/pkg/errors"
+)
+
+/*
+Encrypted media:
+
+	| aes-cbc cipher | first 10 bytes of hmac-sha256(iv + cipher) |
+
+Sidecar, for streamable media (video/audio),
+the stream `iv + cipher` is split into windows:
+
+	[n*64K, (n+1)*64K+16)
+
+each window is signed with mac_key, truncated to 10 bytes,
+all combined together. As CBC can be decrypted from any block,
+the media can be played and seeked without downloading the whole file.
+*/
+const (
+	mediaMacLen   = 10
+	sidecarWindow = 64 * 1024
+)
+
+type media_keys struct {
+	iv      []byte
+	key     []byte
+	mac_key []byte
+}
+
+func expand_media_key(media_key, salt []byte) (*media_keys, error) {
+	if len(media_key) != 0x20 {
+		return nil, errors.New(`invalid media key`)
+	}
+	x, e := algo.HkdfSha256(
+		media_key,
+		make([]byte, 0x20), // 00000000000000000000000000000000
+		salt,
+		0x50,
+	)
+	if e != nil {
+		return nil, e
+	}
+	return &media_keys{
+		iv:      x[0:0x10],
+		key:     x[0x10:0x30],
+		mac_key: x[0x30:],
+	}, nil
+}
+
+// only streamable media has sidecar
+func has_sidecar(t pb.Media_Type) bool {
+	return t == pb.Media_Video || t == pb.Media_Ptt
+}
+
+// media with field `sidecar`
+type streamable interface {
+	Sidecar() []byte
+}
+
+// generates sidecar from the stream `iv + cipher`
+type sidecar_writer struct {
+	mac_key []byte
+	pos     int64
+
+	cur  hash.Hash // current window
+	prev hash.Hash // previous window, still waiting for its last 16 bytes
+	out  []byte
+}
+
+func (s *sidecar_writer) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		off := s.pos % sidecarWindow
+		if off == 0 { // new window begins
+			s.prev = s.cur
+			s.cur = hmac.New(sha256.New, s.mac_key)
+		}
+		l := sidecarWindow - off
+		if l > int64(len(p)) {
+			l = int64(len(p))
+		}
+		s.cur.Write(p[:l])
+
+		// the first 16 bytes also belong to previous window
+		if s.prev != nil && off < aes.BlockSize {
+			m := aes.BlockSize - off
+			if m > l {
+				m = l
+			}
+			s.prev.Write(p[:m])
+			if off+m == aes.BlockSize {
+				s.out = append(s.out, s.prev.Sum(nil)[:mediaMacLen]...)
+				s.prev = nil
+			}
+		}
+
+		s.pos += l
+		p = p[l:]
+	}
+	return n, nil
+}
+func (s *sidecar_writer) Sum() []byte {
+	ret := s.out
+	if s.prev != nil { // stream ends within the overlapping 16 bytes
+		ret = append(ret, s.prev.Sum(nil)[:mediaMacLen]...)
+	}
+	if s.cur != nil {
+		ret = append(ret, s.cur.Sum(nil)[:mediaMacLen]...)
+	}
+	return ret
+}
+
+type media_enc_result struct {
+	FileHash    []byte
+	FileLength  int64
+	EncFileHash []byte
+	EncLength   int64
+	Sidecar     []byte // only when `with_sidecar`
+}
+
+// encrypt from `r` to `w` chunk by chunk, with constant memory
+func encryptMediaStream(
+	media_key, salt []byte,
+	with_sidecar bool,
+	r io.Reader, w io.Writer,
+) (*media_enc_result, error) {
+	k, e := expand_media_key(media_key, salt)
+	if e != nil {
+		return nil, e
+	}
+	block, e := aes.NewCipher(k.key)
+	if e != nil {
+		return nil, e
+	}
+	cbc := cipher.NewCBCEncrypter(block, k.iv)
+
+	file_hash := sha256.New()
+	enc_hash := sha256.New()
+	mac := hmac.New(sha256.New, k.mac_key)
+	mac.Write(k.iv)
+
+	var sc *sidecar_writer
+	if with_sidecar {
+		sc = &sidecar_writer{mac_key: k.mac_key}
+		sc.Write(k.iv)
+	}
+
+	ret := &media_enc_result{}
+	out := io.MultiWriter(w, enc_hash)
+
+	emit := func(c []byte) error {
+		cbc.CryptBlocks(c, c)
+		mac.Write(c)
+		if sc != nil {
+			sc.Write(c)
+		}
+		ret.EncLength += int64(len(c))
+		_, e := out.Write(c)
+		return e
+	}
+
+	buf := make([]byte, sidecarWindow, sidecarWindow+aes.BlockSize)
+	for {
+		n, e := io.ReadFull(r, buf)
+		file_hash.Write(buf[:n])
+		ret.FileLength += int64(n)
+
+		if e == nil {
+			if e := emit(buf); e != nil {
+				return nil, e
+			}
+			continue
+		}
+		if e != io.EOF && e != io.ErrUnexpectedEOF {
+			return nil, e
+		}
+
+		// last chunk, pkcs7 padding
+		pad := aes.BlockSize - n%aes.BlockSize
+		last := append(buf[:n], bytes.Repeat([]byte{byte(pad)}, pad)...)
+		if e := emit(last); e != nil {
+			return nil, e
+		}
+		break
+	}
+
+	mc := mac.Sum(nil)[:mediaMacLen]
+	if _, e := out.Write(mc); e != nil {
+		return nil, e
+	}
+	ret.EncLength += mediaMacLen
+
+	ret.FileHash = file_hash.Sum(nil)
+	ret.EncFileHash = enc_hash.Sum(nil)
+	if sc != nil {
+		ret.Sidecar = sc.Sum()
+	}
+	return ret, nil
+}
+
+func pkcs7_unpad(b []byte) ([]byte, error) {
+	if len(b) == 0 || len(b)%aes.BlockSize != 0 {
+		return nil, errors.New(`invalid padding`)
+	}
+	pad := int(b[len(b)-1])
+	if pad == 0 || pad > aes.BlockSize {
+		return nil, errors.New(`invalid padding`)
+	}
+	for _, x := range b[len(b)-pad:] {
+		if int(x) != pad {
+			return nil, errors.New(`invalid padding`)
+		}
+	}
+	return b[:len(b)-pad], nil
+}
+
+/*
+Decrypt from `r` to `w` chunk by chunk, with constant memory,
+encFileHash/mac/fileHash are verified at the end.
+
+Plaintext is written to `w` before the verification,
+it should be discarded if error returned.
+*/
+func decryptMediaStream(m Media, r io.Reader, w io.Writer) error {
+	k, e := expand_media_key(m.MediaKey(), m.SaltString())
+	if e != nil {
+		return e
+	}
+	block, e := aes.NewCipher(k.key)
+	if e != nil {
+		return e
+	}
+	cbc := cipher.NewCBCDecrypter(block, k.iv)
+
+	file_hash := sha256.New()
+	enc_hash := sha256.New()
+	mac := hmac.New(sha256.New, k.mac_key)
+	mac.Write(k.iv)
+
+	// the tail (last block + mac) is kept until the end
+	const tail = aes.BlockSize + mediaMacLen
+
+	buf := make([]byte, sidecarWindow+tail)
+	held := 0
+	for {
+		n, e := io.ReadFull(r, buf[held:])
+		enc_hash.Write(buf[held : held+n])
+		held += n
+
+		if e == nil {
+			c := buf[:sidecarWindow]
+			mac.Write(c)
+			cbc.CryptBlocks(c, c)
+			file_hash.Write(c)
+			if _, e := w.Write(c); e != nil {
+				return e
+			}
+			held = copy(buf, buf[sidecarWindow:])
+			continue
+		}
+		if e != io.EOF && e != io.ErrUnexpectedEOF {
+			return e
+		}
+		break
+	}
+
+	// at least one aes block
+	if held < tail || (held-mediaMacLen)%aes.BlockSize != 0 {
+		return ErrMediaTruncated
+	}
+
+	if h := m.EncFileHash(); len(h) > 0 {
+		if !hmac.Equal(enc_hash.Sum(nil), h) {
+			return errors.Wrap(ErrMediaHashMismatch, `encFileHash`)
+		}
+	}
+
+	c := buf[:held-mediaMacLen]
+	mac.Write(c)
+	if !hmac.Equal(mac.Sum(nil)[:mediaMacLen], buf[held-mediaMacLen:held]) {
+		return ErrMediaMacMismatch
+	}
+
+	cbc.CryptBlocks(c, c)
+	c, e = pkcs7_unpad(c)
+	if e != nil {
+		return e
+	}
+	file_hash.Write(c)
+	if _, e := w.Write(c); e != nil {
+		return e
+	}
+
+	if h := m.FileHash(); len(h) > 0 {
+		if !hmac.Equal(file_hash.Sum(nil), h) {
+			return errors.Wrap(ErrMediaHashMismatch, `fileHash`)
+		}
+	}
+	return nil
+}
+
+/*
+Decrypt plaintext range [from, to) with sidecar, `to` <= 0 means to the end.
+
+`fetch(offset)` returns the encrypted file from `offset` to the end,
+only the required windows are read from it.
+Each window is verified against the sidecar before written to `w`.
+*/
+func decryptMediaRange(
+	m Media,
+	from, to int64,
+	fetch func(offset int64) (io.ReadCloser, error),
+	w io.Writer,
+) error {
+	x, ok := m.(streamable)
+	if !ok || len(x.Sidecar()) == 0 {
+		return errors.New(`media has no sidecar`)
+	}
+	sidecar := x.Sidecar()
+
+	if from < 0 || (to > 0 && to <= from) {
+		return errors.New(`invalid range`)
+	}
+
+	k, e := expand_media_key(m.MediaKey(), m.SaltString())
+	if e != nil {
+		return e
+	}
+	block, e := aes.NewCipher(k.key)
+	if e != nil {
+		return e
+	}
+
+	// window n starts at stream offset n*64K, plaintext offset n*64K
+	n := from / sidecarWindow
+	if (n+1)*mediaMacLen > int64(len(sidecar)) {
+		return errors.New(`range out of file`)
+	}
+
+	// the stream is `iv + cipher`, file on cdn is `cipher + mac`
+	var stream io.Reader
+	offset := n * sidecarWindow
+	if offset == 0 {
+		rc, e := fetch(0)
+		if e != nil {
+			return e
+		}
+		defer rc.Close()
+		stream = io.MultiReader(bytes.NewReader(k.iv), rc)
+	} else {
+		rc, e := fetch(offset - aes.BlockSize)
+		if e != nil {
+			return e
+		}
+		defer rc.Close()
+		stream = rc
+	}
+
+	const win_len = sidecarWindow + aes.BlockSize
+	// read one more block + mac, to know if the window contains the last block
+	const want = win_len + aes.BlockSize + mediaMacLen
+
+	win := make([]byte, 0, want)
+	for ; ; n++ {
+		held := len(win)
+		win = win[:want]
+		rn, e := io.ReadFull(stream, win[held:])
+		win = win[:held+rn]
+
+		eof := false
+		if e == io.EOF || e == io.ErrUnexpectedEOF {
+			eof = true
+		} else if e != nil {
+			return e
+		}
+
+		data := win
+		final := false
+		if eof {
+			end := len(win) - mediaMacLen
+			if end < aes.BlockSize || (end-aes.BlockSize)%aes.BlockSize != 0 {
+				return ErrMediaTruncated
+			}
+			data = win[:end]
+			final = end <= win_len
+		}
+		if len(data) > win_len {
+			data = data[:win_len]
+		}
+
+		if (n+1)*mediaMacLen > int64(len(sidecar)) {
+			return errors.New(`sidecar too short`)
+		}
+		mac := hmac.New(sha256.New, k.mac_key)
+		mac.Write(data)
+		if !hmac.Equal(mac.Sum(nil)[:mediaMacLen], sidecar[n*mediaMacLen:(n+1)*mediaMacLen]) {
+			return ErrMediaMacMismatch
+		}
+
+		plain := make([]byte, len(data)-aes.BlockSize)
+		cipher.NewCBCDecrypter(block, data[:aes.BlockSize]).CryptBlocks(plain, data[aes.BlockSize:])
+		if final && len(plain) > 0 {
+			plain, e = pkcs7_unpad(plain)
+			if e != nil {
+				return e
+			}
+		}
+
+		// the part within [from, to)
+		start := n * sidecarWindow
+		lo, hi := int64(0), int64(len(plain))
+		if from > start {
+			lo = from - start
+		}
+		if to > 0 && to-start < hi {
+			hi = to - start
+		}
+		if lo < hi {
+			if _, e := w.Write(plain[lo:hi]); e != nil {
+				return e
+			}
+		}
+
+		if final || (to > 0 && start+sidecarWindow >= to) {
+			return nil
+		}
+
+		// keep the overlapping part for next window
+		win = win[:copy(win, win[sidecarWindow:])]
+	}
+}
@@ -0,0 +1,123 @@
+package core
+
+import (
+	"bytes"
+	"crypto/aes"
+	"io"
+	"math/rand"
+	"testing"
+
+	"wa/pb"
+)
+
+const win = sidecarWindow
+
+// encrypted video of `n` random bytes
+func enc_video(t *testing.T, n int) ([]byte, []byte, *Video) {
+	t.Helper()
+	rnd := rand.New(rand.NewSource(int64(n)))
+	plain := make([]byte, n)
+	rnd.Read(plain)
+	key := make([]byte, 0x20)
+	rnd.Read(key)
+
+	v := &Video{P: &pb.Video{}}
+	var enc bytes.Buffer
+	r, e := encryptMediaStream(key, v.SaltString(), true, bytes.NewReader(plain), &enc)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if r.EncLength != int64(enc.Len()) || r.FileLength != int64(n) {
+		t.Fatalf("length: enc %d/%d, file %d/%d", r.EncLength, enc.Len(), r.FileLength, n)
+	}
+	v.P.MediaKey = key
+	v.P.FileHash = r.FileHash
+	v.P.EncFileHash = r.EncFileHash
+	v.P.Sidecar = r.Sidecar
+	return plain, enc.Bytes(), v
+}
+
+func TestMediaStreamRoundTrip(t *testing.T) {
+	for _, n := range []int{0, 1, 15, 16, 17, win - 1, win, win + 1, 2*win + 15, 3 * win} {
+		plain, enc, v := enc_video(t, n)
+
+		var dec bytes.Buffer
+		if e := decryptMediaStream(v, bytes.NewReader(enc), &dec); e != nil {
+			t.Fatalf("size %d: %v", n, e)
+		}
+		if !bytes.Equal(dec.Bytes(), plain) {
+			t.Fatalf("size %d: plaintext mismatch", n)
+		}
+
+		// sidecar has one mac for each window of `iv + cipher`
+		if want := (len(enc) - mediaMacLen + aes.BlockSize + win - 1) / win * mediaMacLen; len(v.P.Sidecar) != want {
+			t.Fatalf("size %d: sidecar %d bytes, want %d", n, len(v.P.Sidecar), want)
+		}
+	}
+}
+
+func TestMediaStreamTampered(t *testing.T) {
+	_, enc, v := enc_video(t, win+100)
+	enc[win/2] ^= 1
+
+	v.P.EncFileHash = nil // skip it, to reach the mac check
+	e := decryptMediaStream(v, bytes.NewReader(enc), io.Discard)
+	if e != ErrMediaMacMismatch {
+		t.Fatalf("got %v, want ErrMediaMacMismatch", e)
+	}
+
+	e = decryptMediaStream(v, bytes.NewReader(enc[:len(enc)-1]), io.Discard)
+	if e != ErrMediaTruncated {
+		t.Fatalf("got %v, want ErrMediaTruncated", e)
+	}
+}
+
+func TestMediaRange(t *testing.T) {
+	// 2*win-1: the cipher ends exactly at a window boundary
+	for _, n := range []int{3*win + 100, 2*win - 1} {
+		plain, enc, v := enc_video(t, n)
+
+		fetch := func(offset int64) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(enc[offset:])), nil
+		}
+
+		for _, from := range []int{0, 16, win - 16, win - 1, win, win + 1, 2*win + 15} {
+			if from >= n {
+				continue
+			}
+			for _, to := range []int{0, from + 1, from + 16, win + 1, from + win, n} {
+				if to != 0 && (to <= from || to > n) {
+					continue
+				}
+				var dec bytes.Buffer
+				if e := decryptMediaRange(v, int64(from), int64(to), fetch, &dec); e != nil {
+					t.Fatalf("size %d [%d, %d): %v", n, from, to, e)
+				}
+				want := plain[from:]
+				if to > 0 {
+					want = plain[from:to]
+				}
+				if !bytes.Equal(dec.Bytes(), want) {
+					t.Fatalf("size %d [%d, %d): got %d bytes, want %d",
+						n, from, to, dec.Len(), len(want))
+				}
+			}
+		}
+	}
+}
+
+func TestMediaRangeTampered(t *testing.T) {
+	_, enc, v := enc_video(t, 2*win)
+	enc[win+100] ^= 1
+
+	fetch := func(offset int64) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(enc[offset:])), nil
+	}
+	// the first window is still good
+	if e := decryptMediaRange(v, 0, 100, fetch, io.Discard); e != nil {
+		t.Fatal(e)
+	}
+	if e := decryptMediaRange(v, win, 0, fetch, io.Discard); e != ErrMediaMacMismatch {
+		t.Fatalf("got %v, want ErrMediaMacMismatch", e)
+	}
+}
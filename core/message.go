@@ -2,7 +2,6 @@ package core
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
@@ -17,6 +16,7 @@ import (
 	"wa/pb"
 	"wa/signal/protocol"
 	"wa/signal/session"
+	"wa/stanza"
 	"wa/xmpp"
 
 	"github.com/pkg/errors"
@@ -96,6 +96,40 @@ func NewMessageContentFromDecrypted(
 		if e := proto.Unmarshal(media.Serialize(), bc); e == nil {
 			ret.P.Document = bc
 		}
+	case pb.Media_Location:
+		bc := &pb.Location{}
+		if e := proto.Unmarshal(media.Serialize(), bc); e == nil {
+			ret.P.Location = bc
+		}
+	case pb.Media_LiveLocation:
+		bc := &pb.LiveLocation{}
+		if e := proto.Unmarshal(media.Serialize(), bc); e == nil {
+			ret.P.LiveLocation = bc
+		}
+	case pb.Media_Poll_Creation:
+		if x, ok := media.(*PollCreation); ok {
+			x.FillMessage(ret.P)
+		}
+	case pb.Media_Poll_Update:
+		bc := &pb.PollUpdate{}
+		if e := proto.Unmarshal(media.Serialize(), bc); e == nil {
+			ret.P.PollUpdate = bc
+		}
+	case pb.Media_Reaction:
+		bc := &pb.Reaction{}
+		if e := proto.Unmarshal(media.Serialize(), bc); e == nil {
+			ret.P.Reaction = bc
+		}
+	case pb.Media_Protocol:
+		bc := &pb.ProtocolMessage{}
+		if e := proto.Unmarshal(media.Serialize(), bc); e == nil {
+			ret.P.Protocol = bc
+		}
+	case pb.Media_Buttons, pb.Media_Buttons_Response,
+		pb.Media_List, pb.Media_List_Response,
+		pb.Media_Template, pb.Media_Template_Reply,
+		pb.Media_Unknown:
+		media.FillMessage(ret.P)
 	}
 
 	return ret
@@ -124,8 +158,72 @@ func (mc *MessageContent) GetMedia() (
 	if mc.P.Document != nil {
 		return &Document{P: mc.P.Document}
 	}
+	if mc.P.Location != nil {
+		return &Location{P: mc.P.Location}
+	}
+	if mc.P.LiveLocation != nil {
+		return &LiveLocation{P: mc.P.LiveLocation}
+	}
+	if poll := poll_creation(mc.P); poll != nil {
+		// keep the secret with the poll
+		pc := proto.Clone(poll).(*pb.PollCreation)
+		pc.EncKey = mc.P.GetMessageContextInfo().GetMessageSecret()
+		return &PollCreation{P: pc}
+	}
+	if mc.P.PollUpdate != nil {
+		return &PollUpdate{P: mc.P.PollUpdate}
+	}
+	if mc.P.Reaction != nil {
+		return &Reaction{P: mc.P.Reaction}
+	}
+	if mc.P.Protocol != nil {
+		return &Protocol{P: mc.P.Protocol}
+	}
+	if mc.P.Buttons != nil {
+		return &Buttons{P: mc.P.Buttons}
+	}
+	if mc.P.ButtonsResponse != nil {
+		return &ButtonsResponse{P: mc.P.ButtonsResponse}
+	}
+	if mc.P.List != nil {
+		return &List{P: mc.P.List}
+	}
+	if mc.P.ListResponse != nil {
+		return &ListResponse{P: mc.P.ListResponse}
+	}
+	if mc.P.Template != nil {
+		return &Template{P: mc.P.Template}
+	}
+	if mc.P.TemplateButtonReply != nil {
+		return &TemplateReply{P: mc.P.TemplateButtonReply}
+	}
+	if inner := ephemeral_inner(mc.P); inner != nil {
+		// the poll secret can be on the wrapper
+		if inner.MessageContextInfo == nil && mc.P.MessageContextInfo != nil {
+			inner = proto.Clone(inner).(*pb.Message)
+			inner.MessageContextInfo = mc.P.MessageContextInfo
+		}
+		return (&MessageContent{P: inner}).GetMedia()
+	}
+	if inner := view_once_inner(mc.P); inner != nil {
+		media := (&MessageContent{P: inner}).GetMedia()
+		set_view_once(media)
+		return media
+	}
 
-	return &TodoMedia{}
+	raw, _ := proto.Marshal(mc.P)
+	return &TodoMedia{Raw: raw}
+}
+
+// newer clients send polls in the V2/V3 fields, with the same shape
+func poll_creation(m *pb.Message) *pb.PollCreation {
+	if m.PollCreation != nil {
+		return m.PollCreation
+	}
+	if m.PollCreationMessageV2 != nil {
+		return m.PollCreationMessageV2
+	}
+	return m.PollCreationMessageV3
 }
 
 func (mc *MessageContent) Skdm() (*protocol.SenderKeyDistributionMessage, error) {
@@ -186,14 +284,23 @@ func build_participants_node(
 	media_t pb.Media_Type,
 	media Media,
 ) ([]*xmpp.Node, error) {
-	ret := []*xmpp.Node{}
-
 	// get all expanded jids, including multi-device
 	// eg: [`111@s.whatsapp.net`, `111.0:2@s.whatsapp.net`, `222@...`]
 	jids, e := expand_jids_devices(a, jids)
 	if e != nil {
 		return nil, e
 	}
+	return build_to_nodes(a, jids, padded_msg, media_t, media)
+}
+
+// same as build_participants_node, but `jids` are already expanded
+func build_to_nodes(
+	a *Acc, jids []string,
+	padded_msg []byte,
+	media_t pb.Media_Type,
+	media Media,
+) ([]*xmpp.Node, error) {
+	ret := []*xmpp.Node{}
 
 	// create session if not exists
 	map_sb, e := a.ensure_session_builder(jids)
@@ -222,7 +329,7 @@ func build_participants_node(
 			{Key: `type`, Value: msg_type_str(encMsg.Type())},
 		}
 		// `ptt`/`...`
-		if media_t != pb.Media_Text {
+		if media.MsgCategory() == `media` {
 			Attrs = append(Attrs, &xmpp.KeyValue{
 				Key: `mediatype`, Value: MediaTypeStr(media.Type()),
 			})
@@ -251,26 +358,62 @@ func (c Core) SendMsg(j *ajson.Json) *ajson.Json {
 		return NewErrRet(e)
 	}
 
+	media, e := a.media_from_json(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	return a.send_msg(j, media)
+}
+
+// parse `media_type` and `media` of SendMsg/SendGroupMsg,
+// the raw file is uploaded first
+func (a *Acc) media_from_json(j *ajson.Json) (Media, error) {
 	media_t := MediaTypeInt(j.Get(`media_type`).String())
 	if media_t == pb.Media_Unknown {
-		return NewErrRet(errors.New(`unsupported media_type`))
+		return nil, errors.New(`unsupported media_type`)
 	}
 
 	// parse media from json
 	media, e := NewMedia(media_t)
 	if e != nil {
-		return NewErrRet(e)
+		return nil, e
 	}
 	mj, ok := j.TryGet(`media`)
 	if !ok {
-		return NewErrRet(errors.New(`fail parse media json`))
+		return nil, errors.New(`fail parse media json`)
 	}
 	// parse Media from json
-	if media.FillFromJson(mj) != nil {
-		return NewErrRet(errors.New(`fail parse media json`))
+	if is_raw_media(media, mj) { // upload the file first
+		if media, e = a.upload_media(media_t, mj); e != nil {
+			return nil, e
+		}
+	} else if media.FillFromJson(mj) != nil {
+		return nil, errors.New(`fail parse media json`)
+	}
+	return media, nil
+}
+
+// SendMsg with parsed `media`
+func (a *Acc) send_msg(j *ajson.Json, media Media) *ajson.Json {
+	media_t := media.Type()
+
+	if e := a.check_interactive(media); e != nil {
+		return NewErrRet(e)
+	}
+	// quoted/mentions
+	ci, e := context_info_from_json(j)
+	if e != nil {
+		return NewErrRet(e)
 	}
 	pmsg := &pb.Message{}
 	media.FillMessage(pmsg)
+	set_context_info(pmsg, ci)
+	a.stamp_ephemeral(pmsg, j.Get(`jid`).String())
+	if view_once, _ := j.Get(`view_once`).TryBool(); view_once {
+		if e := wrap_view_once(pmsg); e != nil {
+			return NewErrRet(e)
+		}
+	}
 
 	p, _ := proto.Marshal(pmsg)
 	padded := crypto.RandomPadMsg(p)
@@ -282,6 +425,20 @@ func (c Core) SendMsg(j *ajson.Json) *ajson.Json {
 	if e != nil || len(ptcps) == 0 {
 		return NewErrRet(errors.New("wrong jid: " + jid))
 	}
+	recipients := to_node_jids(ptcps)
+
+	// a copy for own companion devices
+	own_jids, e := a.own_device_jids()
+	if e != nil {
+		return NewErrRet(e)
+	}
+	if len(own_jids) > 0 {
+		dsm, e := build_device_sent_nodes(a, own_jids, jid, pmsg, media_t, media)
+		if e != nil {
+			return NewErrRet(errors.Wrap(e, `fail build device sent message`))
+		}
+		ptcps = append(ptcps, dsm...)
+	}
 
 	// WamE2eMessageSend
 	{
@@ -297,14 +454,19 @@ func (c Core) SendMsg(j *ajson.Json) *ajson.Json {
 		}
 	}
 
+	msg_id := strings.ToUpper(algo.Md5Str([]byte(arand.Uuid4())))
+
 	n := &xmpp.Node{
 		Tag: `message`,
 		Attrs: []*xmpp.KeyValue{
 			{Key: `to`, Value: jid, Type: 1},
 			{Key: `type`, Value: media.MsgCategory()},
-			{Key: `id`, Value: strings.ToUpper(algo.Md5Str([]byte(arand.Uuid4())))},
+			{Key: `id`, Value: msg_id},
 		},
 	}
+	if edit := msg_edit_attr(media); edit != `` {
+		n.Attrs = append(n.Attrs, &xmpp.KeyValue{Key: `edit`, Value: edit})
+	}
 
 	if len(ptcps) == 1 { // only phone, only 1 child node
 		n.Children = []*xmpp.Node{ptcps[0].Children[0]}
@@ -315,6 +477,13 @@ func (c Core) SendMsg(j *ajson.Json) *ajson.Json {
 				Children: ptcps,
 			},
 		}
+		my_jid, e := a.Store.GetMyJid()
+		if e != nil {
+			return NewErrRet(e)
+		}
+		n.Attrs = append(n.Attrs, &xmpp.KeyValue{
+			Key: `phash`, Value: phash(my_jid, to_node_jids(ptcps)),
+		})
 	}
 
 	if j.Exists(`url_number`) { // sending msg from clicking url `https://api.whatsapp.com/send?phone=...`
@@ -329,6 +498,11 @@ func (c Core) SendMsg(j *ajson.Json) *ajson.Json {
 	if e != nil {
 		return NewErrRet(e)
 	}
+	// rejected by server, nothing is delivered
+	if code, failed := ack_error(nr); failed {
+		a.Log.Warning("msg %s rejected, error: %s", msg_id, code)
+		return NewJsonRet(nr.ToJson())
+	}
 
 	// only save on success, don't know the failure value for messageSendResult
 	{
@@ -344,6 +518,7 @@ func (c Core) SendMsg(j *ajson.Json) *ajson.Json {
 			a.Log.Error(`fail WamMessageSend: ` + er.Error())
 		}
 	}
+	a.on_msg_sent(msg_id, jid, pmsg, recipients, media)
 
 	return NewJsonRet(nr.ToJson())
 }
@@ -428,33 +603,25 @@ func decodePkMsg(sc *session.Cipher, cipher []byte) ([]byte, error) {
 }
 
 func New_Hook_Msg(a *Acc) func(...any) error {
-	// Decrypt and Replace node.Data
+	// decrypt and fill the decoded content to `m.Media`
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		attrs := n.MapAttrs()
+		m, ok := args[0].(*stanza.Message)
+		if !ok {
+			return nil
+		}
 
 		// return if group msg (has attr `participant`)
-		{
-			if _, ok := attrs[`participant`]; ok {
-				return nil
-			}
+		if m.IsGroup() {
+			return nil
 		}
 
-		// must exists
-		msg_id, ok1 := attrs[`id`]
-		from, ok2 := attrs[`from`]
-		t, ok3 := attrs[`t`]
+		n := m.Node()
+		attrs := n.MapAttrs()
 
-		if !ok1 || !ok2 || !ok3 {
-			return nil
-		}
+		msg_id := m.Id
+		from := m.From
 
-		var msg_type string
-		wmNode, ok := n.FindChild(func(ch *xmpp.Node) bool {
-			msg_type, _ = ch.GetAttr(`type`)
-			return ch.Tag == `enc` && (msg_type == `pkmsg` || msg_type == `msg`)
-		})
+		wm, ok := m.WhisperEnc()
 		if !ok {
 			return nil
 		}
@@ -528,18 +695,14 @@ func New_Hook_Msg(a *Acc) func(...any) error {
 				if e != nil {
 					return e
 				}
-				attrs := n.Children[0].MapAttrs()
-				cipher_type := attrs[`type`]
-				cipher_ver := attrs[`v`]
-
-				timestamp, _ := strconv.Atoi(t)
+				timestamp := int(m.T)
 				international := !strings.HasPrefix(from, dev.Cc)
 
 				a.wam_e2e_message_recv(
 					media.Type(),
 					timestamp,
-					wam_cipher_text_type(cipher_type),
-					wam_cipher_text_ver(cipher_ver),
+					wam_cipher_text_type(wm.Type),
+					wam_cipher_text_ver(wm.V),
 					0, /*dest*/
 				)
 
@@ -550,22 +713,18 @@ func New_Hook_Msg(a *Acc) func(...any) error {
 					international)
 			}
 
-			// 3. modify node
-			n.Attrs = append(n.Attrs, &xmpp.KeyValue{
-				Key:   `media`,
-				Value: media.ToJson().ToString(),
-			})
-			n.Attrs = append(n.Attrs, &xmpp.KeyValue{
-				Key:   `media_type`,
-				Value: MediaTypeStr(media.Type()),
-			})
-
-			// too long, not necessary to clients
-			n.Children = n.Children[:0]
+			// 3. attach decrypted content, pushed to client
+			m.MediaType = MediaTypeStr(media.Type())
+			m.Media = media
 
 			// 5. store decoded to db
-			return a.Store.SaveDecodedMessage(
-				msg_id, uint32(media.Type()), media.Serialize())
+			if e := a.Store.SaveDecodedMessage(
+				msg_id, uint32(media.Type()), media.Serialize()); e != nil {
+				return e
+			}
+			a.set_message_expiration(msg_id, m.T, content)
+
+			return a.handle_decoded_message(m, from, from, media)
 		})
 
 		// 1. save to database
@@ -595,27 +754,107 @@ func New_Hook_Msg(a *Acc) func(...any) error {
 		var e error
 		var content *MessageContent
 
-		switch msg_type {
+		switch wm.Type {
 		case `msg`:
 			content, e = a.decodeWspMsgTextFromJid(
-				from, wmNode.Data, protocol.WHISPER_TYPE)
+				from, wm.Data, protocol.WHISPER_TYPE)
 		case `pkmsg`:
 			content, e = a.decodeWspMsgTextFromJid(
-				from, wmNode.Data, protocol.PREKEY_TYPE)
+				from, wm.Data, protocol.PREKEY_TYPE)
 		default:
-			return errors.New("wtf msg_type: " + msg_type)
+			return errors.New("wtf msg_type: " + wm.Type)
 		}
 
 		// retry on decode error
 		if e != nil {
 			// send `retry` if any error occurs
 			a.Log.Warning("decode msg fail: %s, retry", e.Error())
+			a.push_decrypt_error(msg_id, from, e)
 			return ev.Fire(`retry`)
 		}
 
 		return ev.Fire(`success`, content)
 	}
 }
+
+// send with SendGroupMsg or SendMsg, depends on `jid`
+func send_chat_msg(c Core, jid string, j *ajson.Json) *ajson.Json {
+	if strings.HasSuffix(jid, `@g.us`) {
+		j.Set(`gid`, jid)
+		return c.SendGroupMsg(j)
+	}
+	return c.SendMsg(j)
+}
+
+// the sender of the message that `key` refers to,
+// `sender` is who sent the key
+func (a *Acc) message_key_sender(key *pb.MessageKey, sender string) string {
+	if key.GetFromMe() {
+		return sender
+	}
+	if ptcp := key.GetParticipant(); ptcp != `` {
+		return clear_jid_device(ptcp)
+	}
+	my_jid, _ := a.Store.GetMyJid()
+	return my_jid
+}
+
+// the server acks a rejected message with attr `error`,
+// eg: <ack class="message" error="479" .../>
+func ack_error(nr *xmpp.Node) (string, bool) {
+	return nr.GetAttr(`error`)
+}
+
+// things to keep after a message is sent,
+// `recipients` are the peer devices, without own devices
+func (a *Acc) on_msg_sent(
+	msg_id, chat string, pmsg *pb.Message, recipients []string, media Media,
+) {
+	a.track_msg_recipients(msg_id, chat, recipients)
+	a.keep_sent_msg(msg_id, chat, pmsg)
+
+	switch x := media.(type) {
+	case *PollCreation:
+		my_jid, e := a.Store.GetMyJid()
+		if e != nil {
+			return
+		}
+		if e := a.save_poll(msg_id, chat, my_jid, x); e != nil {
+			a.Log.Error("fail save poll %s: %s", msg_id, e.Error())
+		}
+	}
+}
+
+// `edit` attr of <message> for revoke/edit
+func msg_edit_attr(media Media) string {
+	if p, ok := media.(*Protocol); ok {
+		return p.Edit
+	}
+	return ``
+}
+
+// messages that are pushed as typed events rather than `message`,
+// eg: reaction, status
+func (a *Acc) handle_decoded_message(
+	m *stanza.Message, chat, sender string, media Media,
+) error {
+	switch x := media.(type) {
+	case *Reaction:
+		return a.handle_reaction(m, chat, sender, x)
+	case *Protocol:
+		return a.handle_protocol(m, chat, sender, x)
+	case *PollCreation:
+		return a.handle_poll_creation(m, chat, sender, x)
+	case *PollUpdate:
+		return a.handle_poll_update(m, chat, sender, x)
+	case *ButtonsResponse, *ListResponse, *TemplateReply:
+		return a.handle_interactive_response(m, chat, sender, x)
+	}
+	if chat == StatusBroadcast {
+		return a.handle_status(m, sender, media)
+	}
+	return nil
+}
 func (a *Acc) retry_message(
 	msg_id string,
 	attrs map[string]string,
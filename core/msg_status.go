@@ -0,0 +1,197 @@
+package core
+
+import (
+	"time"
+
+	"ajson"
+	"wa/def"
+	"wa/stanza"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	MsgStatus_Sent      = 0
+	MsgStatus_Delivered = 1
+	MsgStatus_Read      = 2
+	MsgStatus_Played    = 3
+)
+
+func msg_status_str(status uint32) string {
+	switch status {
+	case MsgStatus_Delivered:
+		return `delivered`
+	case MsgStatus_Read:
+		return `read`
+	case MsgStatus_Played:
+		return `played`
+	}
+	return `sent`
+}
+
+// `type` attr of <receipt>
+func receipt_msg_status(type_ string) (uint32, bool) {
+	switch type_ {
+	case ``:
+		return MsgStatus_Delivered, true
+	case `read`:
+		return MsgStatus_Read, true
+	case `played`:
+		return MsgStatus_Played, true
+	}
+	return 0, false
+}
+
+// the overall status of a sent message,
+// `delivered` only if every device has it, but a user has `read`/`played`
+// the message if any of its devices has, as the other devices won't send it
+func min_msg_status(rs []*def.MsgRecipient) uint32 {
+	var dev_min uint32 = MsgStatus_Played
+	user_max := map[string]uint32{}
+	for _, r := range rs {
+		if r.Status < dev_min {
+			dev_min = r.Status
+		}
+		u := clear_jid_device(r.Jid)
+		if st, ok := user_max[u]; !ok || r.Status > st {
+			user_max[u] = r.Status
+		}
+	}
+	var user_min uint32 = MsgStatus_Played
+	for _, st := range user_max {
+		if st < user_min {
+			user_min = st
+		}
+	}
+	if user_min >= MsgStatus_Read {
+		return user_min
+	}
+	return dev_min
+}
+
+// 111.0:2@s.whatsapp.net, same format as sent
+func normalize_device_jid(jid string) string {
+	recid, devid, e := split_jid(jid)
+	if e != nil {
+		return jid
+	}
+	return build_jid(recid, devid)
+}
+
+// expect receipts from all recipient devices
+func (a *Acc) track_msg_recipients(msg_id, chat string, jids []string) {
+	devs := []string{}
+	for _, jid := range jids {
+		devs = append(devs, normalize_device_jid(jid))
+	}
+	if e := a.Store.AddMsgRecipients(msg_id, chat, devs); e != nil {
+		a.Log.Error("fail save recipients of msg %s: %s", msg_id, e.Error())
+	}
+}
+
+// update the status of the recipient device,
+// push `message_status` when all devices reach a new status
+func (a *Acc) on_msg_receipt(msg_id, jid string, status uint32, t int64) {
+	before, e := a.Store.ListMsgRecipients(msg_id)
+	if e != nil || len(before) == 0 { // not sent by us
+		return
+	}
+	n, e := a.Store.SetMsgRecipientStatus(msg_id, jid, status, t)
+	if e != nil {
+		a.Log.Error("fail update status of msg %s: %s", msg_id, e.Error())
+		return
+	}
+	if n == 0 {
+		return
+	}
+	after, e := a.Store.ListMsgRecipients(msg_id)
+	if e != nil {
+		return
+	}
+	old_status, new_status := min_msg_status(before), min_msg_status(after)
+	if new_status == old_status {
+		return
+	}
+
+	j := ajson.New()
+	j.Set(`id`, msg_id)
+	j.Set(`chat`, after[0].Chat)
+	j.Set(`status`, msg_status_str(new_status))
+	j.Set(`t`, t)
+	a.push(`message_status`, j)
+}
+
+// the receipt itself is still acked by New_Hook_Receipt
+func New_Hook_MsgStatus(a *Acc) func(...any) error {
+	return func(args ...any) error {
+		r, ok := args[0].(*stanza.Receipt)
+		if !ok {
+			return nil
+		}
+		// receipt of message sent by own companion device
+		if r.Recipient != `` {
+			return nil
+		}
+		status, ok := receipt_msg_status(r.Type)
+		if !ok {
+			return nil
+		}
+		from := r.From
+		if r.Participant != `` {
+			from = r.Participant
+		}
+		jid := normalize_device_jid(from)
+		t := r.T
+		if t == 0 {
+			t = time.Now().Unix()
+		}
+		for _, id := range r.Ids() {
+			a.on_msg_receipt(id, jid, status, t)
+		}
+		return nil
+	}
+}
+
+/*
+params:
+
+	msg_id: message sent by us
+
+result:
+
+	{
+		status: sent/delivered/read/played,  // see min_msg_status
+		recipients: [{jid, status, t}, ...]
+	}
+*/
+func (c Core) GetMsgStatus(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	msg_id, e := j.Get(`msg_id`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'msg_id'`))
+	}
+	rs, e := a.Store.ListMsgRecipients(msg_id)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	if len(rs) == 0 {
+		return NewErrRet(errors.New(`no record of msg ` + msg_id))
+	}
+
+	ret_arr := []*ajson.Json{}
+	for _, r := range rs {
+		x := ajson.New()
+		x.Set(`jid`, r.Jid)
+		x.Set(`status`, msg_status_str(r.Status))
+		x.Set(`t`, r.T)
+		ret_arr = append(ret_arr, x)
+	}
+	ret := NewSucc()
+	ret.Set(`chat`, rs[0].Chat)
+	ret.Set(`status`, msg_status_str(min_msg_status(rs)))
+	ret.Set(`recipients`, ret_arr)
+	return ret
+}
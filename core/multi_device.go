@@ -4,9 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"wa/signal/protocol"
-	"wa/xmpp"
-
-	"github.com/pkg/errors"
+	"wa/stanza"
 )
 
 /*
@@ -47,42 +45,33 @@ import (
 
 func New_Hook_MultiDeviceRemove(a *Acc) func(...any) error {
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		attrs := n.MapAttrs()
-
-		type_ := attrs[`type`]
-		if type_ != `devices` {
+		nt, ok := args[0].(*stanza.Notification)
+		if !ok || nt.Devices == nil {
 			return nil
 		}
-
-		ch, ok := n.FindChildByTag(`remove`)
-		if !ok {
+		if nt.Devices.Action != `remove` {
 			return nil
 		}
 
-		ch, ok = ch.FindChildByTag(`device`)
-		if !ok {
-			return nil
-		}
-
-		jid, ok := ch.GetAttr(`jid`)
-		if !ok {
-			return errors.New("no 'jid' attr")
-		}
+		for _, jid := range nt.Devices.Jids {
+			recid, devid, e := split_jid(jid)
+			if e != nil {
+				return e
+			}
+			addr := protocol.NewSignalAddress(
+				strconv.Itoa(int(recid)), devid)
 
-		recid, devid, e := split_jid(jid)
-		if e != nil {
-			return e
-		}
-		addr := protocol.NewSignalAddress(
-			strconv.Itoa(int(recid)), devid)
+			a.Store.DeleteIdentity(addr)
+			a.Store.DeleteSession(addr)
+			a.Store.DeleteSenderKey(addr)
 
-		a.Store.DeleteIdentity(addr)
-		a.Store.DeleteSession(addr)
-		a.Store.DeleteSenderKey(addr)
+			a.Store.DelMultiDevice(recid, devid)
 
-		a.Store.DelMultiDevice(recid, devid)
+			// the removed device still has our sender keys
+			if e := a.rotate_member_sender_keys(recid); e != nil {
+				return e
+			}
+		}
 
 		return nil
 	}
@@ -127,36 +116,24 @@ func New_Hook_MultiDeviceRemove(a *Acc) func(...any) error {
 */
 func New_Hook_MultiDeviceAdd(a *Acc) func(...any) error {
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		attrs := n.MapAttrs()
-
-		type_ := attrs[`type`]
-		if type_ != `devices` {
+		nt, ok := args[0].(*stanza.Notification)
+		if !ok || nt.Devices == nil {
 			return nil
 		}
-
-		ch, ok := n.FindChildByTag(`add`)
-		if !ok {
+		if nt.Devices.Action != `add` {
 			return nil
 		}
 
-		ch, ok = ch.FindChildByTag(`device`)
-		if !ok {
-			return nil
-		}
-
-		jid, ok := ch.GetAttr(`jid`)
-		if !ok {
-			return errors.New("no 'jid' attr")
-		}
-
-		recid, devid, e := split_jid(jid)
-		if e != nil {
-			return e
+		for _, jid := range nt.Devices.Jids {
+			recid, devid, e := split_jid(jid)
+			if e != nil {
+				return e
+			}
+			if e := a.Store.AddMultiDevice(recid, devid); e != nil {
+				return e
+			}
 		}
-
-		return a.Store.AddMultiDevice(recid, devid)
+		return nil
 	}
 }
 
@@ -183,26 +160,15 @@ update:
 */
 func New_Hook_MultiDeviceUpdate(a *Acc) func(...any) error {
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		attrs := n.MapAttrs()
-
-		type_ := attrs[`type`]
-		if type_ != `devices` {
+		nt, ok := args[0].(*stanza.Notification)
+		if !ok || nt.Devices == nil {
 			return nil
 		}
-
-		_, ok := n.FindChildByTag(`update`)
-		if !ok {
+		if nt.Devices.Action != `update` {
 			return nil
 		}
 
-		from, ok := attrs[`from`]
-		if !ok {
-			return errors.New("no 'from' attr")
-		}
-
-		recid, _, e := split_jid(from)
+		recid, _, e := split_jid(nt.From)
 		if e != nil {
 			return e
 		}
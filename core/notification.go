@@ -1,31 +1,30 @@
 package core
 
 import (
+	"wa/stanza"
 	"wa/xmpp"
 )
 
 func New_Hook_Notification(a *Acc) func(...any) error {
-	// Decrypt and Replace node.Data
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		type_, _ := n.GetAttr(`type`)
-		from, _ := n.GetAttr(`from`)
-		id, _ := n.GetAttr(`id`)
-		participant, _ := n.GetAttr(`participant`)
-
-		if type_ == `` || from == `` || id == `` {
+		nt, ok := args[0].(*stanza.Notification)
+		if !ok {
 			return nil
 		}
+		// still ack it, otherwise server keeps sending it
+		if nt.DecodeErr != nil {
+			a.Log.Warning("fail decode notification %s: %s", nt.Id, nt.DecodeErr.Error())
+		}
+
 		attrs := []*xmpp.KeyValue{
 			{Key: `class`, Value: `notification`},
-			{Key: `id`, Value: id},
-			{Key: `to`, Value: from},
-			{Key: `type`, Value: type_},
+			{Key: `id`, Value: nt.Id},
+			{Key: `to`, Value: nt.From},
+			{Key: `type`, Value: nt.Type},
 		}
-		if len(participant) > 0 {
+		if len(nt.Participant) > 0 {
 			attrs = append(attrs, &xmpp.KeyValue{
-				Key: `participant`, Value: participant,
+				Key: `participant`, Value: nt.Participant,
 			})
 		}
 		a.Noise.WriteXmppNode(&xmpp.Node{
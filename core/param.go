@@ -14,9 +14,19 @@ func NewRet(ec int) *ajson.Json {
 func NewSucc() *ajson.Json {
 	return NewRet(0)
 }
+
+// errors with their own ErrCode, eg: ErrMedia
+type codedError interface {
+	ErrCode() int
+}
+
 func NewErrRet(e error) *ajson.Json {
 	j := ajson.New()
-	j.Set("ErrCode", 3423)
+	if x, ok := errors.Cause(e).(codedError); ok {
+		j.Set("ErrCode", x.ErrCode())
+	} else {
+		j.Set("ErrCode", 3423)
+	}
 	j.Set("ErrMsg", e.Error())
 	return j
 }
@@ -2,6 +2,7 @@ package core
 
 import (
 	"event"
+	"wa/stanza"
 	"wa/xmpp"
 )
 
@@ -16,7 +17,11 @@ func send_ping_ack(a *Acc) {
 }
 func New_Hook_ServerPing(a *Acc) func(...any) error {
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
+		st, ok := args[0].(stanza.Stanza)
+		if !ok {
+			return nil
+		}
+		n := st.Node()
 
 		xmlns, _ := n.GetAttr(`xmlns`)
 
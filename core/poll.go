@@ -0,0 +1,197 @@
+package core
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+
+	"ajson"
+	"algo"
+	"event"
+	"wa/def"
+	"wa/pb"
+	"wa/stanza"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+const PollVoteUseCase = `Poll Vote`
+
+/*
+key  = hkdf(secret, info = poll_id + creator + voter + "Poll Vote")
+aad  = poll_id + "\x00" + voter
+jids are without device
+*/
+func poll_vote_key(secret []byte, poll_id, creator, voter string) ([]byte, []byte, error) {
+	info := []byte(poll_id + creator + voter + PollVoteUseCase)
+
+	key, e := algo.HkdfSha256(secret, make([]byte, 0x20), info, 0x20)
+	if e != nil {
+		return nil, nil, e
+	}
+	aad := []byte(poll_id + "\x00" + voter)
+	return key, aad, nil
+}
+
+func decrypt_poll_vote(
+	p *def.Poll, voter string, ev *pb.PollEncValue,
+) (*pb.PollVote, error) {
+	key, aad, e := poll_vote_key(p.Secret, p.MsgId, p.Creator, voter)
+	if e != nil {
+		return nil, e
+	}
+	block, e := aes.NewCipher(key)
+	if e != nil {
+		return nil, e
+	}
+	gcm, e := cipher.NewGCM(block)
+	if e != nil {
+		return nil, e
+	}
+	if len(ev.GetEncIv()) != gcm.NonceSize() {
+		return nil, errors.New(`invalid vote iv`)
+	}
+	plain, e := gcm.Open(nil, ev.GetEncIv(), ev.GetEncPayload(), aad)
+	if e != nil {
+		return nil, errors.Wrap(e, `fail decrypt vote`)
+	}
+	vote := &pb.PollVote{}
+	if e := proto.Unmarshal(plain, vote); e != nil {
+		return nil, e
+	}
+	return vote, nil
+}
+
+// option names of the sha256 hashes in a vote
+func poll_option_names(p *def.Poll, selected [][]byte) []string {
+	ret := []string{}
+	for _, hash := range selected {
+		for _, opt := range p.Options {
+			if bytes.Equal(algo.Sha256([]byte(opt)), hash) {
+				ret = append(ret, opt)
+				break
+			}
+		}
+	}
+	return ret
+}
+
+func (a *Acc) save_poll(msg_id, chat, creator string, pc *PollCreation) error {
+	if len(pc.P.GetEncKey()) == 0 {
+		return errors.New(`poll without message secret`)
+	}
+	options := []string{}
+	for _, opt := range pc.P.GetOptions() {
+		options = append(options, opt.GetOptionName())
+	}
+	return a.Store.SavePoll(&def.Poll{
+		MsgId:           msg_id,
+		Chat:            clear_jid_device(chat),
+		Creator:         clear_jid_device(creator),
+		Secret:          pc.P.GetEncKey(),
+		Name:            pc.P.GetName(),
+		Options:         options,
+		SelectableCount: pc.P.GetSelectableOptionsCount(),
+	})
+}
+
+// keep the poll for decrypting votes, it's still pushed as message
+func (a *Acc) handle_poll_creation(
+	m *stanza.Message, chat, sender string, pc *PollCreation,
+) error {
+	if e := a.save_poll(m.Id, chat, sender, pc); e != nil {
+		a.Log.Error("fail save poll %s: %s", m.Id, e.Error())
+	}
+	return nil
+}
+
+// decrypt the vote, push `poll_vote` instead of the raw message
+func (a *Acc) handle_poll_update(
+	m *stanza.Message, chat, sender string, pu *PollUpdate,
+) error {
+	voter := clear_jid_device(sender)
+	poll_id := pu.P.GetPollCreationMessageKey().GetId()
+
+	p, e := a.Store.GetPoll(poll_id)
+	if e != nil {
+		a.Log.Error("vote for unknown poll %s: %s", poll_id, e.Error())
+		return nil
+	}
+	vote, e := decrypt_poll_vote(p, voter, pu.P.GetVote())
+	if e != nil {
+		a.Log.Error("fail decrypt vote of poll %s from %s: %s", poll_id, voter, e.Error())
+		return nil
+	}
+	if e := a.Store.SetPollVote(
+		poll_id, voter, vote.GetSelectedOptions(), pu.P.GetSenderTimestampMs(),
+	); e != nil {
+		a.Log.Error("fail save vote of poll %s: %s", poll_id, e.Error())
+	}
+
+	j := ajson.New()
+	j.Set(`chat`, clear_jid_device(chat))
+	j.Set(`voter`, voter)
+	j.Set(`id`, m.Id)
+	j.Set(`poll_id`, poll_id)
+	j.Set(`selected_options`, poll_option_names(p, vote.GetSelectedOptions()))
+	j.Set(`t`, m.T)
+	a.push(`poll_vote`, j)
+
+	return event.Stop
+}
+
+/*
+params:
+
+	poll_id: msg id of the poll
+
+result:
+
+	{
+		name, selectableOptionsCount,
+		options: [{name, count, voters: [jid...]}, ...]
+	}
+*/
+func (c Core) GetPollResults(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	poll_id, e := j.Get(`poll_id`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'poll_id'`))
+	}
+	p, e := a.Store.GetPoll(poll_id)
+	if e != nil {
+		return NewErrRet(errors.Wrap(e, `poll not found`))
+	}
+	votes, e := a.Store.ListPollVotes(poll_id)
+	if e != nil {
+		return NewErrRet(e)
+	}
+
+	// option -> voters
+	tally := map[string][]string{}
+	for _, v := range votes {
+		for _, opt := range poll_option_names(p, v.Selected) {
+			tally[opt] = append(tally[opt], v.Voter)
+		}
+	}
+
+	rj := NewSucc()
+	rj.Set(`name`, p.Name)
+	rj.Set(`selectableOptionsCount`, p.SelectableCount)
+	for _, opt := range p.Options {
+		voters := tally[opt]
+		if voters == nil {
+			voters = []string{}
+		}
+		oj := ajson.New()
+		oj.Set(`name`, opt)
+		oj.Set(`count`, len(voters))
+		oj.Set(`voters`, voters)
+		rj.Add(`options`, oj)
+	}
+	return rj
+}
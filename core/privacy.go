@@ -29,8 +29,8 @@ func (a *Acc) get_jabber_iq_privacy() error {
 	})
 	return e
 }
-func (a *Acc) get_status_privacy() error {
-	_, e := a.Noise.WriteReadXmppNode(&xmpp.Node{
+func (a *Acc) get_status_privacy() (*xmpp.Node, error) {
+	return a.Noise.WriteReadXmppNode(&xmpp.Node{
 		Tag: `iq`,
 		Attrs: []*xmpp.KeyValue{
 			{Key: `id`, Value: a.Noise.NextIqId_2()},
@@ -44,7 +44,6 @@ func (a *Acc) get_status_privacy() error {
 			},
 		},
 	})
-	return e
 }
 func (a *Acc) get_privacy(xmlns string) error {
 	_, e := a.Noise.WriteReadXmppNode(&xmpp.Node{
@@ -0,0 +1,100 @@
+package core
+
+import (
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"algo"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// nested messages deeper than this are kept as base64
+const protoFieldsMaxDepth = 16
+
+/*
+Decode protobuf without schema, field number -> value,
+same as the dumps in pb/Message.proto:
+
+	{"1": "text", "3": {"8": "base64..."}, "9": [1, 2]}
+
+repeated fields become array,
+bytes are decoded as string or nested message if possible, otherwise base64
+*/
+func proto_fields(bs []byte, depth int) (map[string]any, bool) {
+	ret := map[string]any{}
+
+	for len(bs) > 0 {
+		num, typ, n := protowire.ConsumeTag(bs)
+		if n < 0 {
+			return nil, false
+		}
+		bs = bs[n:]
+
+		var v any
+		switch typ {
+		case protowire.VarintType:
+			x, n := protowire.ConsumeVarint(bs)
+			if n < 0 {
+				return nil, false
+			}
+			v, bs = x, bs[n:]
+		case protowire.Fixed32Type:
+			x, n := protowire.ConsumeFixed32(bs)
+			if n < 0 {
+				return nil, false
+			}
+			v, bs = x, bs[n:]
+		case protowire.Fixed64Type:
+			x, n := protowire.ConsumeFixed64(bs)
+			if n < 0 {
+				return nil, false
+			}
+			v, bs = x, bs[n:]
+		case protowire.BytesType:
+			x, n := protowire.ConsumeBytes(bs)
+			if n < 0 {
+				return nil, false
+			}
+			v, bs = proto_bytes_value(x, depth), bs[n:]
+		default: // deprecated groups
+			return nil, false
+		}
+
+		key := strconv.Itoa(int(num))
+		switch old := ret[key].(type) {
+		case nil:
+			ret[key] = v
+		case []any:
+			ret[key] = append(old, v)
+		default:
+			ret[key] = []any{old, v}
+		}
+	}
+	return ret, true
+}
+
+func proto_bytes_value(bs []byte, depth int) any {
+	if is_printable(bs) {
+		return string(bs)
+	}
+	if depth < protoFieldsMaxDepth {
+		if m, ok := proto_fields(bs, depth+1); ok {
+			return m
+		}
+	}
+	return algo.B64Enc(bs)
+}
+
+func is_printable(bs []byte) bool {
+	if !utf8.Valid(bs) {
+		return false
+	}
+	for _, r := range string(bs) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
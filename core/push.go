@@ -0,0 +1,27 @@
+package core
+
+import (
+	"ajson"
+	"wa/def"
+)
+
+// anything that can be pushed to client
+type Pushable interface {
+	ToJson() *ajson.Json
+}
+
+// events generated by us rather than received from server,
+// eg: `identity_changed`
+type PushEvent struct {
+	Tag  string
+	Data *ajson.Json
+}
+
+func (p *PushEvent) ToJson() *ajson.Json {
+	p.Data.Set(`Tag`, p.Tag)
+	return p.Data
+}
+
+func (a *Acc) push(tag string, data *ajson.Json) {
+	a.Event.Fire(def.Ev_Push, &PushEvent{Tag: tag, Data: data})
+}
@@ -0,0 +1,96 @@
+package core
+
+import (
+	"time"
+
+	"ajson"
+	"event"
+	"wa/stanza"
+
+	"github.com/pkg/errors"
+)
+
+/*
+params:
+
+	jid: chat jid, or gid for group
+	msg_id: the message reacted to
+	from_me: optional, if the message was sent by us
+	participant: optional, sender of the message in group
+	emoji: empty to remove the reaction
+*/
+func (c Core) SendReaction(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	jid, e := j.Get(`jid`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'jid'`))
+	}
+	msg_id, e := j.Get(`msg_id`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'msg_id'`))
+	}
+	from_me, _ := j.Get(`from_me`).TryBool()
+	emoji := j.Get(`emoji`).String()
+
+	key := ajson.New()
+	key.Set(`remoteJid`, jid)
+	key.Set(`fromMe`, from_me)
+	key.Set(`id`, msg_id)
+	if ptcp, e := j.Get(`participant`).TryString(); e == nil {
+		key.Set(`participant`, ptcp)
+	}
+	mj := ajson.New()
+	mj.Set(`key`, key.Data())
+	mj.Set(`text`, emoji)
+
+	j.Set(`media_type`, `reaction`)
+	j.Set(`media`, mj.Data())
+
+	rj := send_chat_msg(c, jid, j)
+	if rj.Get(`ErrCode`).Int() != 0 {
+		return rj
+	}
+
+	my_jid, e := a.Store.GetMyJid()
+	if e != nil {
+		return NewErrRet(e)
+	}
+	if e := a.Store.SetMessageReaction(
+		msg_id, my_jid, emoji, time.Now().UnixMilli(),
+	); e != nil {
+		a.Log.Error("fail save reaction to %s: %s", msg_id, e.Error())
+	}
+	return rj
+}
+
+// store the reaction against the target message and
+// push `reaction` instead of the raw message
+func (a *Acc) handle_reaction(
+	m *stanza.Message, chat, sender string, r *Reaction,
+) error {
+	sender = clear_jid_device(sender)
+	target_id := r.P.GetKey().GetId()
+	emoji := r.P.GetText()
+
+	if e := a.Store.SetMessageReaction(
+		target_id, sender, emoji, r.P.GetSenderTimestampMs(),
+	); e != nil {
+		a.Log.Error("fail save reaction to %s: %s", target_id, e.Error())
+	}
+
+	j := ajson.New()
+	j.Set(`chat`, clear_jid_device(chat))
+	j.Set(`sender`, sender)
+	j.Set(`id`, m.Id)
+	j.Set(`target_id`, target_id)
+	j.Set(`target_sender`, a.message_key_sender(r.P.GetKey(), sender))
+	j.Set(`emoji`, emoji)
+	j.Set(`removed`, emoji == ``)
+	j.Set(`t`, m.T)
+	a.push(`reaction`, j)
+
+	return event.Stop
+}
@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	"ajson"
+	"wa/stanza"
 	"wa/xmpp"
 
 	"github.com/pkg/errors"
@@ -205,30 +206,24 @@ func (a *Acc) retry_receipt(
 
 func New_Hook_Receipt(a *Acc) func(...any) error {
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		ma := n.MapAttrs()
+		r, ok := args[0].(*stanza.Receipt)
+		if !ok {
+			return nil
+		}
 
 		// all ack have `id`, `to`
-		id, _ := ma[`id`]
-		from, _ := ma[`from`]
-
-		// group has `participant`
-		participant, _ := ma[`participant`]
-
-		// type == `Receive` or `Read`
-		type_, _ := ma[`type`]
-
 		Attrs := []*xmpp.KeyValue{
 			{Key: `class`, Value: `receipt`},
-			{Key: `id`, Value: id},
-			{Key: `to`, Value: from},
+			{Key: `id`, Value: r.Id},
+			{Key: `to`, Value: r.From},
 		}
-		if len(participant) > 0 {
-			Attrs = append(Attrs, &xmpp.KeyValue{Key: `participant`, Value: participant})
+		// group has `participant`
+		if len(r.Participant) > 0 {
+			Attrs = append(Attrs, &xmpp.KeyValue{Key: `participant`, Value: r.Participant})
 		}
-		if len(type_) > 0 {
-			Attrs = append(Attrs, &xmpp.KeyValue{Key: `type`, Value: type_})
+		// type == `Receive` or `Read`
+		if len(r.Type) > 0 {
+			Attrs = append(Attrs, &xmpp.KeyValue{Key: `type`, Value: r.Type})
 		}
 		return a.Noise.WriteXmppNode(&xmpp.Node{
 			Tag:   `ack`,
@@ -0,0 +1,219 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"phoenix"
+	"wa/crypto"
+	"wa/pb"
+	"wa/signal/groups"
+	"wa/signal/protocol"
+	"wa/signal/session"
+	"wa/stanza"
+	"wa/xmpp"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// stop re-sending when peer keeps failing
+const MaxResendRetry = 5
+
+// plaintexts of sent messages are purged after this
+const SentMessageKeep = 24 * time.Hour
+
+func (a *Acc) keep_sent_msg(msg_id, chat string, pmsg *pb.Message) {
+	p, e := proto.Marshal(pmsg)
+	if e != nil {
+		return
+	}
+	if e := a.Store.SaveSentMessage(msg_id, chat, p); e != nil {
+		a.Log.Error("fail keep sent msg %s: %s", msg_id, e.Error())
+	}
+}
+
+// peer can't decrypt our message, re-send it to that device
+func New_Hook_RetryReceipt(a *Acc) func(...any) error {
+	return func(args ...any) error {
+		r, ok := args[0].(*stanza.Receipt)
+		if !ok || r.Type != `retry` || r.Retry == nil {
+			return nil
+		}
+		// must use another goroutine, WriteReadXmppNode would block the receiving
+		a.Wg.Add(1)
+		go func() {
+			defer phoenix.Ignore(nil)
+			defer a.Wg.Done()
+
+			if e := a.resend_msg(r); e != nil {
+				a.Log.Error("fail resend msg %s: %s", r.Id, e.Error())
+			}
+		}()
+		return nil // the receipt still needs ack
+	}
+}
+
+// session of the device that sent the retry receipt,
+// rebuilt with the prekey bundle if it's attached
+func (a *Acc) retry_session(r *stanza.Receipt, device string) (*session.Builder, error) {
+	if r.Retry.Keys != nil {
+		if len(r.Retry.Registration) != 4 {
+			return nil, errors.New(`invalid registration id`)
+		}
+		er := EncryptResult{Jid: device}
+		er.parse_keys(r.Retry.Keys.Children)
+		er.RegId = crypto.BE2U32(r.Retry.Registration)
+		return a.session_from_encrypt(device, er)
+	}
+
+	// still fails with current session, fetch new prekey
+	if r.Retry.Count >= 2 {
+		recid, devid, e := split_jid(device)
+		if e != nil {
+			return nil, e
+		}
+		a.Store.DeleteSession(protocol.NewSignalAddress(fmt.Sprintf("%d", recid), devid))
+	}
+	map_sb, e := a.ensure_session_builder([]string{device})
+	if e != nil {
+		return nil, e
+	}
+	return map_sb[device], nil
+}
+
+func (a *Acc) resend_msg(r *stanza.Receipt) error {
+	if r.Retry.Count > MaxResendRetry {
+		return errors.Errorf("too much retry: %d", r.Retry.Count)
+	}
+	sm, e := a.Store.GetSentMessage(r.Id)
+	if e != nil {
+		return errors.Wrap(e, `plaintext not kept`)
+	}
+	pmsg := &pb.Message{}
+	if e := proto.Unmarshal(sm.Plain, pmsg); e != nil {
+		return e
+	}
+	media := (&MessageContent{P: pmsg}).GetMedia()
+
+	is_group := r.Participant != ``
+	device := r.From
+	if is_group {
+		device = r.Participant
+	}
+	device = normalize_device_jid(device)
+
+	// 0. only to the devices it was sent to
+	my_jid, e := a.Store.GetMyJid()
+	if e != nil {
+		return e
+	}
+	is_own := clear_jid_device(device) == my_jid
+	if !is_own {
+		ok, e := a.is_msg_recipient(r.Id, device)
+		if e != nil {
+			return e
+		}
+		if !ok {
+			return errors.Errorf("%s is not a recipient", device)
+		}
+	}
+
+	// 1. session
+	sb, e := a.retry_session(r, device)
+	if e != nil {
+		return errors.Wrap(e, `fail rebuild session`)
+	}
+
+	// 2. own companion device shows 1:1 message as outgoing message,
+	// group message is the same for all devices
+	if is_own && !is_group {
+		pmsg = &pb.Message{
+			DeviceSentMessage: &pb.DeviceSentMessage{
+				DestinationJid: proto.String(sm.Chat),
+				Message:        pmsg,
+			},
+		}
+	}
+	// group message is sent directly, along with the sender key
+	if is_group {
+		dev, e := a.Store.GetDev()
+		if e != nil {
+			return e
+		}
+		skn_me := protocol.NewSenderKeyName(
+			sm.Chat, protocol.NewSignalAddress(dev.Cc+dev.Phone, 0))
+		skdm, e := groups.NewGroupSessionBuilder(a.Store).Create(skn_me)
+		if e != nil {
+			return e
+		}
+		pmsg.Grp = &pb.Message_Group{
+			Id:   proto.String(sm.Chat),
+			Skdm: skdm.Serialize(),
+		}
+	}
+
+	// 3. encrypt
+	p, e := proto.Marshal(pmsg)
+	if e != nil {
+		return e
+	}
+	recid, devid, _ := split_jid(device)
+	sc := session.NewCipher(sb, protocol.NewSignalAddress(fmt.Sprintf("%d", recid), devid))
+	enc, e := sc.Encrypt(crypto.RandomPadMsg(p))
+	if e != nil {
+		return e
+	}
+
+	enc_attrs := []*xmpp.KeyValue{
+		{Key: `v`, Value: Msg_Cipher_Ver},
+		{Key: `type`, Value: msg_type_str(enc.Type())},
+		{Key: `count`, Value: strconv.Itoa(r.Retry.Count)},
+	}
+	if media.MsgCategory() == `media` {
+		enc_attrs = append(enc_attrs, &xmpp.KeyValue{
+			Key: `mediatype`, Value: MediaTypeStr(media.Type()),
+		})
+	}
+	attrs := []*xmpp.KeyValue{
+		{Key: `id`, Value: r.Id},
+		{Key: `type`, Value: media.MsgCategory()},
+	}
+	if is_group {
+		attrs = append(attrs,
+			&xmpp.KeyValue{Key: `to`, Value: sm.Chat},
+			&xmpp.KeyValue{Key: `participant`, Value: device},
+		)
+	} else {
+		attrs = append(attrs, &xmpp.KeyValue{Key: `to`, Value: device})
+	}
+
+	a.Log.Info("resend msg %s to %s, retry %d", r.Id, device, r.Retry.Count)
+
+	_, e = a.Noise.WriteReadXmppNode(&xmpp.Node{
+		Tag:   `message`,
+		Attrs: attrs,
+		Children: []*xmpp.Node{
+			{
+				Tag:   `enc`,
+				Attrs: enc_attrs,
+				Data:  enc.Serialize(),
+			},
+		},
+	})
+	return e
+}
+
+func (a *Acc) is_msg_recipient(msg_id, device string) (bool, error) {
+	rs, e := a.Store.ListMsgRecipients(msg_id)
+	if e != nil {
+		return false, e
+	}
+	for _, r := range rs {
+		if r.Jid == device {
+			return true, nil
+		}
+	}
+	return false, nil
+}
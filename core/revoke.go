@@ -0,0 +1,210 @@
+package core
+
+import (
+	"strings"
+	"time"
+
+	"ajson"
+	"event"
+	"wa/pb"
+	"wa/stanza"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// key of a message to revoke/edit, `participant` is only
+// needed for revoking others' message as group admin
+func protocol_key_json(jid, msg_id, participant, my_jid string) *ajson.Json {
+	key := ajson.New()
+	key.Set(`remoteJid`, jid)
+	key.Set(`id`, msg_id)
+	if participant == `` || clear_jid_device(participant) == my_jid {
+		key.Set(`fromMe`, true)
+	} else {
+		key.Set(`fromMe`, false)
+		key.Set(`participant`, participant)
+	}
+	return key
+}
+
+/*
+Delete for everyone
+
+params:
+
+	jid: chat jid, or gid for group
+	msg_id: the message to revoke
+	participant: optional, sender of the message,
+		for group admin revoking others' message
+*/
+func (c Core) RevokeMsg(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	jid, e := j.Get(`jid`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'jid'`))
+	}
+	msg_id, e := j.Get(`msg_id`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'msg_id'`))
+	}
+	my_jid, e := a.Store.GetMyJid()
+	if e != nil {
+		return NewErrRet(e)
+	}
+
+	mj := ajson.New()
+	mj.Set(`key`, protocol_key_json(
+		jid, msg_id, j.Get(`participant`).String(), my_jid).Data())
+	mj.Set(`type`, `revoke`)
+
+	j.Set(`media_type`, `protocol`)
+	j.Set(`media`, mj.Data())
+
+	rj := send_chat_msg(c, jid, j)
+	if rj.Get(`ErrCode`).Int() != 0 {
+		return rj
+	}
+	if e := a.Store.RevokeMessage(msg_id, my_jid); e != nil {
+		a.Log.Error("fail revoke msg %s: %s", msg_id, e.Error())
+	}
+	return rj
+}
+
+/*
+params:
+
+	jid: chat jid, or gid for group
+	msg_id: the message to edit, must be sent by us
+	media_type: optional, default `text`
+	media: the new content, same as SendMsg
+*/
+func (c Core) EditMsg(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	jid, e := j.Get(`jid`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'jid'`))
+	}
+	msg_id, e := j.Get(`msg_id`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'msg_id'`))
+	}
+	media_type, e := j.Get(`media_type`).TryString()
+	if e != nil {
+		media_type = `text`
+	}
+	media, ok := j.TryGet(`media`)
+	if !ok {
+		return NewErrRet(errors.New(`missing 'media'`))
+	}
+	my_jid, e := a.Store.GetMyJid()
+	if e != nil {
+		return NewErrRet(e)
+	}
+
+	edited := ajson.New()
+	edited.Set(`media_type`, media_type)
+	edited.Set(`media`, media.Data())
+
+	mj := ajson.New()
+	mj.Set(`key`, protocol_key_json(jid, msg_id, ``, my_jid).Data())
+	mj.Set(`type`, `edit`)
+	mj.Set(`editedMessage`, edited.Data())
+	mj.Set(`timestampMs`, time.Now().UnixMilli())
+
+	// same content as sent, for saving the edited message
+	p := &Protocol{P: &pb.ProtocolMessage{}}
+	if e := p.FillFromJson(mj); e != nil {
+		return NewErrRet(e)
+	}
+
+	j.Set(`media_type`, `protocol`)
+	j.Set(`media`, mj.Data())
+
+	rj := send_chat_msg(c, jid, j)
+	if rj.Get(`ErrCode`).Int() != 0 {
+		return rj
+	}
+	em := p.EditedMedia()
+	if e := a.Store.EditMessage(
+		msg_id, uint32(em.Type()), em.Serialize(), p.P.GetTimestampMs(),
+	); e != nil {
+		a.Log.Error("fail edit msg %s: %s", msg_id, e.Error())
+	}
+	return rj
+}
+
+// update the stored target message,
+// push `revoke`/`edit` instead of the raw message
+func (a *Acc) handle_protocol(
+	m *stanza.Message, chat, sender string, p *Protocol,
+) error {
+	sender = clear_jid_device(sender)
+
+	if p.P.GetType() == pb.ProtocolMessage_EPHEMERAL_SETTING {
+		return a.on_ephemeral_setting(chat, sender,
+			p.P.GetEphemeralExpiration(), p.P.GetEphemeralSettingTimestamp(), m.T)
+	}
+
+	target_id := p.P.GetKey().GetId()
+	target_sender := a.message_key_sender(p.P.GetKey(), sender)
+
+	j := ajson.New()
+	j.Set(`chat`, clear_jid_device(chat))
+	j.Set(`sender`, sender)
+	j.Set(`id`, m.Id)
+	j.Set(`target_id`, target_id)
+	j.Set(`target_sender`, target_sender)
+	j.Set(`t`, m.T)
+
+	switch p.P.GetType() {
+	case pb.ProtocolMessage_REVOKE:
+		// only the sender, or group admin
+		if target_sender != sender && !a.is_group_admin(chat, sender) {
+			a.Log.Warning("%s revoking msg %s of %s, ignored", sender, target_id, target_sender)
+			return event.Stop
+		}
+		if e := a.Store.RevokeMessage(target_id, sender); e != nil {
+			a.Log.Error("fail revoke msg %s: %s", target_id, e.Error())
+		}
+		j.Set(`by_admin`, target_sender != sender)
+		a.push(`revoke`, j)
+
+	case pb.ProtocolMessage_MESSAGE_EDIT:
+		// only the sender can edit
+		if target_sender != sender {
+			a.Log.Warning("%s editing msg %s of %s, ignored", sender, target_id, target_sender)
+			return event.Stop
+		}
+		edited := p.EditedMedia()
+		if e := a.Store.EditMessage(
+			target_id, uint32(edited.Type()), edited.Serialize(), p.P.GetTimestampMs(),
+		); e != nil {
+			a.Log.Error("fail edit msg %s: %s", target_id, e.Error())
+		}
+		j.Set(`media_type`, MediaTypeStr(edited.Type()))
+		j.Set(`media`, edited.ToJson().Data())
+		a.push(`edit`, j)
+
+	default:
+		return nil
+	}
+	return event.Stop
+}
+
+func (a *Acc) is_group_admin(chat, jid string) bool {
+	if !strings.HasSuffix(chat, `@g.us`) {
+		return false
+	}
+	admin, e := a.Store.IsGroupAdmin(chat, jid)
+	if e != nil && !errors.Is(e, mongo.ErrNoDocuments) {
+		a.Log.Error("fail check group admin %s: %s", jid, e.Error())
+	}
+	return admin
+}
@@ -1,7 +1,7 @@
 package core
 
 import (
-	"wa/xmpp"
+	"wa/stanza"
 
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -28,20 +28,18 @@ import (
 
 func New_Hook_RoutingInfo(a *Acc) func(...any) error {
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		// if contains child `edge_routing`
-		ch, ok := n.FindChildByTag(`edge_routing`)
+		ib, ok := args[0].(*stanza.Ib)
 		if !ok {
 			return nil
 		}
-		ch2, ok := ch.FindChildByTag(`routing_info`)
-		if !ok {
+
+		// if contains child `edge_routing`
+		if ib.RoutingInfo == nil {
 			return nil
 		}
 
 		return a.Store.ModifyConfig(bson.M{
-			`RoutingInfo`: ch2.Data,
+			`RoutingInfo`: ib.RoutingInfo,
 		})
 	}
 }
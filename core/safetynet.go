@@ -2,6 +2,7 @@ package core
 
 import (
 	"ahex"
+	"wa/stanza"
 	"wa/xmpp"
 )
 
@@ -36,23 +37,16 @@ import (
 
 func New_Hook_Safetynet(a *Acc) func(...any) error {
 	return func(args ...any) error {
-		n := args[0].(*xmpp.Node)
-
-		if len(n.Children) == 0 {
-			return nil
-		}
-		ch0 := n.Children[0]
-		if ch0.Tag != `safetynet` {
+		ib, ok := args[0].(*stanza.Ib)
+		if !ok || ib.Safetynet == nil {
 			return nil
 		}
 
-		for _, ch := range ch0.Children {
-			switch ch.Tag {
-			case `attestation`:
-				a.Store.SetSafetynetAttestation()
-			case `verify_apps`:
-				a.Store.SetSafetynetVerifyApps()
-			}
+		if ib.Safetynet.Attestation {
+			a.Store.SetSafetynetAttestation()
+		}
+		if ib.Safetynet.VerifyApps {
+			a.Store.SetSafetynetVerifyApps()
 		}
 		return nil
 	}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"fmt"
+
+	"ajson"
+	"wa/signal/protocol"
+
+	"github.com/pkg/errors"
+)
+
+func (a *Acc) my_sender_key_name(gid string) (*protocol.SenderKeyName, error) {
+	dev, e := a.Store.GetDev()
+	if e != nil {
+		return nil, e
+	}
+	addr_me := protocol.NewSignalAddress(dev.Cc+dev.Phone, 0)
+	return protocol.NewSenderKeyName(gid, addr_me), nil
+}
+
+// Delete own sender key of the group, a new one will be
+// created and distributed on next SendGroupMsg.
+// Removed members still have the old chain key, they can't read
+// messages encrypted with the new one.
+func (a *Acc) rotate_group_sender_key(gid string) error {
+	skn, e := a.my_sender_key_name(gid)
+	if e != nil {
+		return e
+	}
+	if e := a.Store.DeleteGroupSenderKey(skn); e != nil {
+		return errors.Wrap(e, `fail delete sender key of `+gid)
+	}
+	if e := a.Store.DeleteSenderKeyDistribution(gid); e != nil {
+		return e
+	}
+	a.Log.Info("sender key rotated: %s", gid)
+	return nil
+}
+
+// device of a member removed, rotate all groups that he is in
+func (a *Acc) rotate_member_sender_keys(recid uint64) error {
+	gids, e := a.Store.ListGroupIdByMember(fmt.Sprintf("%d@s.whatsapp.net", recid))
+	if e != nil {
+		return e
+	}
+	for _, gid := range gids {
+		if e := a.rotate_group_sender_key(gid); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// devices that have our current sender key `key_id`
+func (a *Acc) sender_key_distributed(gid string, key_id uint32) (map[string]bool, error) {
+	ds, e := a.Store.ListSenderKeyDistribution(gid, key_id)
+	if e != nil {
+		return nil, e
+	}
+	ret := map[string]bool{}
+	for _, d := range ds {
+		ret[build_jid(uint64(d.RecipientId), d.DeviceId)] = true
+	}
+	return ret, nil
+}
+
+// param `gid`
+func (c Core) RotateGroupSenderKey(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	gid, e := j.Get(`gid`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'gid'`))
+	}
+	if e := a.rotate_group_sender_key(gid); e != nil {
+		return NewErrRet(e)
+	}
+	return NewSucc()
+}
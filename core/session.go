@@ -2,12 +2,15 @@ package core
 
 import (
 	"fmt"
+	"time"
 
+	"ajson"
 	"wa/signal/ecc"
 	"wa/signal/keys/identity"
 	"wa/signal/keys/prekey"
 	"wa/signal/protocol"
 	"wa/signal/session"
+	"wa/signal/state/record"
 	"wa/signal/util/bytehelper"
 	"wa/signal/util/optional"
 
@@ -62,38 +65,179 @@ func (a *Acc) ensure_session_builder(
 			return nil, errors.Wrap(e, `WTF, missing Encrypt Result for jid `+jid)
 		}
 
-		recid, devid, _ := split_jid(jid)
-
-		// if bot account has no prekey left
-		var prekey_ ecc.ECPublicKeyable = nil
-		var prekey_id *optional.Uint32 = optional.NewEmptyUint32()
-		if user.Prekey != nil {
-			prekey_ = ecc.NewDjbECPublicKey(user.Prekey)
-			prekey_id = optional.NewOptionalUint32(uint32(user.PrekeyId))
-		} else {
-			a.Log.Debug("prekey is nil")
-		}
-
 		// 2. create session
-		pkb := prekey.NewBundle(
-			user.RegId,
-			devid,
-			prekey_id,
-			user.SpkId,
-			prekey_,
-			ecc.NewDjbECPublicKey(user.Spk),
-			bytehelper.SliceToArray64(user.SpkSig),
-			identity.NewKeyFromBytes(bytehelper.SliceToArray(user.Identity)),
-		)
-
-		peer_addr := protocol.NewSignalAddress(fmt.Sprintf("%d", recid), devid)
-		sb := session.NewBuilder(
-			a.Store, a.Store, a.Store, a.Store, peer_addr)
-		if e := sb.ProcessBundle(pkb); e != nil {
-			return nil, errors.Wrap(e, `fail sb.ProcessBundle `)
+		sb, e := a.session_from_encrypt(jid, user)
+		if e != nil {
+			return nil, e
 		}
 		ret[jid] = sb
 	}
 
 	return ret, nil
 }
+
+// create session with the prekey bundle
+func (a *Acc) session_from_encrypt(
+	jid string, user EncryptResult,
+) (*session.Builder, error) {
+	recid, devid, e := split_jid(jid)
+	if e != nil {
+		return nil, e
+	}
+
+	// if bot account has no prekey left
+	var prekey_ ecc.ECPublicKeyable = nil
+	var prekey_id *optional.Uint32 = optional.NewEmptyUint32()
+	if user.Prekey != nil {
+		prekey_ = ecc.NewDjbECPublicKey(user.Prekey)
+		prekey_id = optional.NewOptionalUint32(uint32(user.PrekeyId))
+	} else {
+		a.Log.Debug("prekey is nil")
+	}
+
+	pkb := prekey.NewBundle(
+		user.RegId,
+		devid,
+		prekey_id,
+		user.SpkId,
+		prekey_,
+		ecc.NewDjbECPublicKey(user.Spk),
+		bytehelper.SliceToArray64(user.SpkSig),
+		identity.NewKeyFromBytes(bytehelper.SliceToArray(user.Identity)),
+	)
+
+	peer_addr := protocol.NewSignalAddress(fmt.Sprintf("%d", recid), devid)
+	sb := session.NewBuilder(
+		a.Store, a.Store, a.Store, a.Store, peer_addr)
+	if e := sb.ProcessBundle(pkb); e != nil {
+		return nil, errors.Wrap(e, `fail sb.ProcessBundle `)
+	}
+	return sb, nil
+}
+
+func session_state_json(st *record.State) *ajson.Json {
+	j := ajson.New()
+	j.Set(`version`, st.Version())
+	j.Set(`previous_counter`, st.PreviousCounter())
+	if st.HasSenderChain() {
+		j.Set(`counter`, st.SenderChainKey().Index())
+	}
+
+	chains := []any{}
+	for _, ch := range st.ReceiverChains() {
+		cj := ajson.New()
+		cj.Set(`index`, ch.ChainKey().Index())
+		cj.Set(`cached_keys`, len(ch.MessageKeys()))
+		cj.Set(`updated_at`, ch.UpdatedAt().Unix())
+		chains = append(chains, cj.Data())
+	}
+	j.Set(`receiver_chains`, chains)
+	return j
+}
+
+// param `jid`: contact jid with device, eg: `123.0:1@s.whatsapp.net`
+// returns:
+//
+//	`current`: version, counter, previous_counter and receiver chains
+//	`archived_states`: number of archived session states
+func (c Core) SessionInfo(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	jid, e := j.Get(`jid`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'jid'`))
+	}
+	recid, devid, e := split_jid(jid)
+	if e != nil {
+		return NewErrRet(errors.Wrap(e, `wrong param 'jid'`))
+	}
+	addr := protocol.NewSignalAddress(fmt.Sprintf("%d", recid), devid)
+
+	if !a.Store.ContainsSession(addr) {
+		return NewErrRet(errors.New(`no session for ` + jid))
+	}
+	rec, e := a.Store.LoadSession(addr)
+	if e != nil {
+		return NewErrRet(e)
+	}
+
+	rj := NewSucc()
+	rj.Set(`current`, session_state_json(rec.SessionState()).Data())
+	rj.Set(`archived_states`, len(rec.PreviousSessionStates()))
+	return rj
+}
+
+func limits_json(l record.Limits) *ajson.Json {
+	j := ajson.New()
+	j.Set(`max_forward_jump`, l.MaxForwardJump)
+	j.Set(`max_message_keys`, l.MaxMessageKeys)
+	j.Set(`max_receiver_chains`, l.MaxReceiverChains)
+	j.Set(`receiver_chain_expiry`, int64(l.ReceiverChainExpiry/time.Second))
+	return j
+}
+
+/*
+Get or set the skipped message key limits, shared by all accounts.
+
+params, all optional, only the given ones are changed:
+
+	max_forward_jump: max messages can be skipped in a chain, >= 1
+	max_message_keys: max cached keys per receiver chain, >= 1
+	max_receiver_chains: max receiver chains per session, >= 1
+	receiver_chain_expiry: in seconds, 0 means never expire
+
+returns the current limits
+*/
+func (c Core) SessionLimits(j *ajson.Json) *ajson.Json {
+	l := record.GetLimits()
+	if x, e := j.Get(`max_forward_jump`).TryInt(); e == nil {
+		if x < 1 {
+			return NewErrRet(errors.New(`'max_forward_jump' must be >= 1`))
+		}
+		l.MaxForwardJump = uint32(x)
+	}
+	if x, e := j.Get(`max_message_keys`).TryInt(); e == nil {
+		l.MaxMessageKeys = x
+	}
+	if x, e := j.Get(`max_receiver_chains`).TryInt(); e == nil {
+		l.MaxReceiverChains = x
+	}
+	if x, e := j.Get(`receiver_chain_expiry`).TryInt(); e == nil {
+		l.ReceiverChainExpiry = time.Duration(x) * time.Second
+	}
+	if e := record.SetLimits(l); e != nil {
+		return NewErrRet(e)
+	}
+
+	rj := NewSucc()
+	rj.Set(`limits`, limits_json(l).Data())
+	return rj
+}
+
+// push the ratchet errors of a failed decryption, so the client knows
+// why the message is lost if the retry doesn't work either
+func (a *Acc) push_decrypt_error(msg_id, from string, e error) {
+	var old_counter *session.OldCounterError
+	var jump *session.ForwardJumpError
+
+	j := ajson.New()
+	switch {
+	case errors.As(e, &old_counter):
+		// duplicate, or its key dropped by MaxMessageKeys
+		j.Set(`reason`, `old_counter`)
+		j.Set(`chain_index`, old_counter.ChainIndex)
+		j.Set(`counter`, old_counter.Counter)
+	case errors.As(e, &jump):
+		j.Set(`reason`, `forward_jump`)
+		j.Set(`chain_index`, jump.ChainIndex)
+		j.Set(`counter`, jump.Counter)
+		j.Set(`max`, jump.Max)
+	default:
+		return
+	}
+	j.Set(`msg_id`, msg_id)
+	j.Set(`jid`, from)
+	a.push(`decrypt_error`, j)
+}
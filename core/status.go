@@ -0,0 +1,253 @@
+package core
+
+import (
+	"time"
+
+	"ajson"
+	"event"
+	"wa/def"
+	"wa/pb"
+	"wa/stanza"
+	"wa/xmpp"
+
+	"github.com/pkg/errors"
+)
+
+const StatusBroadcast = `status@broadcast`
+
+// status updates disappear after 24 hours
+const StatusLifetime = 24 * time.Hour
+
+/*
+the default list in the result of get_status_privacy:
+
+	<privacy>
+		<list type="contacts|blacklist|whitelist" default="true">
+			<user jid="111@s.whatsapp.net"/>
+		</list>
+	</privacy>
+*/
+func parse_status_privacy(nr *xmpp.Node) (string, []string, error) {
+	privacy, ok := nr.FindChildByTag(`privacy`)
+	if !ok {
+		return ``, nil, errors.New(`missing 'privacy' node`)
+	}
+	var list *xmpp.Node
+	for _, ch := range privacy.Children {
+		if ch.Tag != `list` {
+			continue
+		}
+		if list == nil {
+			list = ch
+		}
+		if def_, _ := ch.GetAttr(`default`); def_ == `true` {
+			list = ch
+			break
+		}
+	}
+	if list == nil {
+		return `contacts`, nil, nil
+	}
+	type_, _ := list.GetAttr(`type`)
+	users := []string{}
+	for _, u := range list.Children {
+		if jid, ok := u.GetAttr(`jid`); ok && u.Tag == `user` {
+			users = append(users, jid)
+		}
+	}
+	return type_, users, nil
+}
+
+// who can see the status, by the status privacy setting,
+// `contacts` is nil if not provided
+func (a *Acc) status_audience(contacts []string) ([]string, error) {
+	nr, e := a.get_status_privacy()
+	if e != nil {
+		return nil, errors.Wrap(e, `fail get status privacy`)
+	}
+	mode, users, e := parse_status_privacy(nr)
+	if e != nil {
+		return nil, errors.Wrap(e, `fail get status privacy`)
+	}
+	if mode != `whitelist` && contacts == nil {
+		return nil, errors.Errorf(`'contacts' required for privacy mode %s`, mode)
+	}
+	switch mode {
+	case `whitelist`:
+		return users, nil
+	case `blacklist`:
+		excluded := map[string]bool{}
+		for _, u := range users {
+			excluded[clear_jid_device(u)] = true
+		}
+		ret := []string{}
+		for _, c := range contacts {
+			if !excluded[clear_jid_device(c)] {
+				ret = append(ret, c)
+			}
+		}
+		return ret, nil
+	}
+	return contacts, nil
+}
+
+/*
+params:
+
+	media_type: optional, default `text`,
+		or `image`/`video`/..., same as SendMsg
+	media: same as SendMsg, text status is:
+		{text, textColor, backgroundColor, fontStyle}
+	contacts: ["111@s.whatsapp.net", ...], the address book,
+		required unless the status privacy is `whitelist`
+	participants: optional, send to them regardless of the privacy
+*/
+func (c Core) PostStatus(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	media_type, e := j.Get(`media_type`).TryString()
+	if e != nil {
+		media_type = `text`
+	}
+	mj, ok := j.TryGet(`media`)
+	if !ok {
+		return NewErrRet(errors.New(`missing 'media'`))
+	}
+
+	// text status is sent as extended text, for the colors
+	if media_type == `text` {
+		uj := ajson.New()
+		uj.Set(`data`, mj.Get(`text`).String())
+		for _, k := range []string{`textColor`, `backgroundColor`, `fontStyle`} {
+			if v, ok := mj.TryGet(k); ok {
+				uj.Set(k, v.Data())
+			}
+		}
+		j.Set(`media_type`, `url`)
+		j.Set(`media`, uj.Data())
+	}
+
+	audience, e := j.Get(`participants`).TryStringArray()
+	if e != nil {
+		contacts, e := j.Get(`contacts`).TryStringArray()
+		if e != nil {
+			contacts = nil
+		}
+		audience, e = a.status_audience(contacts)
+		if e != nil {
+			return NewErrRet(e)
+		}
+	}
+	if len(audience) == 0 {
+		return NewErrRet(errors.New(`nobody can see the status`))
+	}
+
+	j.Set(`gid`, StatusBroadcast)
+	j.Set(`participants`, audience)
+	return c.SendGroupMsg(j)
+}
+
+// keep the status for ListStatusUpdates, push `status` instead of the raw message
+func (a *Acc) handle_status(
+	m *stanza.Message, sender string, media Media,
+) error {
+	sender = clear_jid_device(sender)
+
+	if e := a.Store.SaveStatusUpdate(&def.StatusUpdate{
+		MsgId:     m.Id,
+		Sender:    sender,
+		MediaType: uint32(media.Type()),
+		DecMedia:  media.Serialize(),
+		T:         m.T,
+	}); e != nil {
+		a.Log.Error("fail save status %s: %s", m.Id, e.Error())
+	}
+
+	j := ajson.New()
+	j.Set(`id`, m.Id)
+	j.Set(`sender`, sender)
+	j.Set(`media_type`, MediaTypeStr(media.Type()))
+	j.Set(`media`, media.ToJson().Data())
+	j.Set(`t`, m.T)
+	a.push(`status`, j)
+
+	return event.Stop
+}
+
+/*
+status updates of the last 24 hours
+
+params:
+
+	sender: optional, only list updates of this contact
+
+result:
+
+	{statuses: [{id, sender, media_type, media, t, read}, ...]}
+*/
+func (c Core) ListStatusUpdates(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	sender := clear_jid_device(j.Get(`sender`).String())
+
+	sus, e := a.Store.ListStatusUpdates(
+		sender, time.Now().Add(-StatusLifetime).Unix())
+	if e != nil {
+		return NewErrRet(e)
+	}
+
+	ret_arr := []*ajson.Json{}
+	for _, su := range sus {
+		med, e := NewMediaFromBytes(pb.Media_Type(su.MediaType), su.DecMedia)
+		if e != nil {
+			return NewErrRet(e)
+		}
+		x := ajson.New()
+		x.Set(`id`, su.MsgId)
+		x.Set(`sender`, su.Sender)
+		x.Set(`media_type`, MediaTypeStr(med.Type()))
+		x.Set(`media`, med.ToJson().Data())
+		x.Set(`t`, su.T)
+		x.Set(`read`, su.Read)
+		ret_arr = append(ret_arr, x)
+	}
+
+	r := NewSucc()
+	r.Set(`statuses`, ret_arr)
+	return r
+}
+
+/*
+send read receipt for a status update
+
+params:
+
+	msg_id: id of the status update
+*/
+func (c Core) ReadStatus(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	msg_id, e := j.Get(`msg_id`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'msg_id'`))
+	}
+	su, e := a.Store.GetStatusUpdate(msg_id)
+	if e != nil {
+		return NewErrRet(errors.Wrap(e, `status not found`))
+	}
+	if e := a.receipt(
+		msg_id, StatusBroadcast, Receipt_Read, true, su.Sender,
+	); e != nil {
+		return NewErrRet(e)
+	}
+	if e := a.Store.SetStatusUpdateRead(msg_id); e != nil {
+		return NewErrRet(e)
+	}
+	return NewSucc()
+}
@@ -0,0 +1,153 @@
+package core
+
+import (
+	"bytes"
+
+	"ajson"
+	"algo"
+	"wa/pb"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/proto"
+)
+
+// the wrapped message of view-once, nil if not view-once
+func view_once_inner(m *pb.Message) *pb.Message {
+	if m.ViewOnceMessage != nil {
+		return m.ViewOnceMessage.GetMessage()
+	}
+	if m.ViewOnceMessageV2 != nil {
+		return m.ViewOnceMessageV2.GetMessage()
+	}
+	return nil
+}
+
+// only image/video/ptt can be view-once
+func set_view_once(media Media) bool {
+	switch x := media.(type) {
+	case *Image:
+		x.P.ViewOnce = proto.Bool(true)
+	case *Video:
+		x.P.ViewOnce = proto.Bool(true)
+	case *Ptt:
+		x.P.ViewOnce = proto.Bool(true)
+	default:
+		return false
+	}
+	return true
+}
+
+func is_view_once(media Media) bool {
+	switch x := media.(type) {
+	case *Image:
+		return x.P.GetViewOnce()
+	case *Video:
+		return x.P.GetViewOnce()
+	case *Ptt:
+		return x.P.GetViewOnce()
+	}
+	return false
+}
+
+// move the content of `m` into a view-once wrapper
+func wrap_view_once(m *pb.Message) error {
+	inner := &pb.Message{}
+	switch {
+	case m.Image != nil:
+		inner.Image, m.Image = m.Image, nil
+		inner.Image.ViewOnce = proto.Bool(true)
+	case m.Video != nil:
+		inner.Video, m.Video = m.Video, nil
+		inner.Video.ViewOnce = proto.Bool(true)
+	case m.Ptt != nil:
+		inner.Ptt, m.Ptt = m.Ptt, nil
+		inner.Ptt.ViewOnce = proto.Bool(true)
+	default:
+		return errors.New(`only image/video/ptt can be view once`)
+	}
+	m.ViewOnceMessage = &pb.FutureProofMessage{Message: inner}
+	return nil
+}
+
+// drop everything needed for downloading it again
+func purge_view_once(media Media) {
+	switch x := media.(type) {
+	case *Image:
+		x.P.MediaKey, x.P.DirectPath, x.P.MessageUrl = nil, nil, nil
+		x.P.EncFileHash, x.P.Thumbnail = nil, nil
+	case *Video:
+		x.P.MediaKey, x.P.DirectPath, x.P.MessageUrl = nil, nil, nil
+		x.P.EncFileHash, x.P.Thumbnail = nil, nil
+	case *Ptt:
+		x.P.MediaKey, x.P.DirectPath, x.P.MessageUrl = nil, nil, nil
+		x.P.EncFileHash = nil
+	}
+}
+
+/*
+Download a received view-once media, it can only be opened once,
+the media keys are purged from db afterwards
+
+params:
+
+	msg_id: the view-once message
+
+result:
+
+	{media_type, media: base64 of the decrypted file}
+*/
+func (c Core) OpenViewOnce(j *ajson.Json) *ajson.Json {
+	a, e := GetAccFromJson(j)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	msg_id, e := j.Get(`msg_id`).TryString()
+	if e != nil {
+		return NewErrRet(errors.New(`missing 'msg_id'`))
+	}
+	m, e := a.Store.GetMessage(msg_id)
+	if e != nil {
+		return NewErrRet(errors.Wrap(e, `message not found`))
+	}
+	if !m.Decrypted {
+		return NewErrRet(errors.New(`message not decrypted`))
+	}
+	if m.ViewOnceOpened {
+		return NewErrRet(errors.New(`view once already opened`))
+	}
+	media, e := NewMediaFromBytes(pb.Media_Type(m.MediaType), m.DecMedia)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	if !is_view_once(media) {
+		return NewErrRet(errors.New(`not a view once message`))
+	}
+
+	// mark it opened before downloading, so it can't be opened twice
+	// by concurrent calls, the keys are still in `media`
+	purged, e := NewMediaFromBytes(pb.Media_Type(m.MediaType), m.DecMedia)
+	if e != nil {
+		return NewErrRet(e)
+	}
+	purge_view_once(purged)
+	if e := a.Store.OpenViewOnce(msg_id, purged.Serialize()); e != nil {
+		if errors.Is(e, mongo.ErrNoDocuments) {
+			return NewErrRet(errors.New(`view once already opened`))
+		}
+		return NewErrRet(e)
+	}
+
+	var dec bytes.Buffer
+	if e := a.download_media(media, &dec); e != nil {
+		if e := a.Store.UnopenViewOnce(msg_id, m.DecMedia); e != nil {
+			a.Log.Error("fail revert view once %s: %s", msg_id, e.Error())
+		}
+		return NewErrRet(e)
+	}
+
+	ret := NewSucc()
+	ret.Set(`media_type`, MediaTypeStr(media.Type()))
+	ret.Set(`media`, algo.B64Enc(dec.Bytes()))
+	return ret
+}
@@ -185,6 +185,9 @@ func UnPadMsg(data []byte) ([]byte, error) {
 	}
 	last := int(data[len(data)-1])
 
+	if len(data) < last {
+		return nil, errors.New(`invalid data to unpad: ` + ahex.Enc(data))
+	}
 	p := len(data) - 1
 	for i := 0; i < last; i++ {
 		if int(data[p]) != last {
@@ -192,8 +195,5 @@ func UnPadMsg(data []byte) ([]byte, error) {
 		}
 		p--
 	}
-	if len(data) < last {
-		return nil, errors.New(`invalid data to unpad: ` + ahex.Enc(data))
-	}
 	return data[0 : len(data)-last], nil
 }
@@ -35,6 +35,9 @@ type Store struct {
 
 	muSession  sync.Mutex
 	muWamEvent sync.RWMutex
+
+	// called when peer identity changed, or a new key is pending for approve
+	OnIdentityChange func(*def.IdentityHistory)
 }
 
 /*
@@ -60,6 +63,15 @@ var colWamSchedule *mongo.Collection
 var colWamEvent *mongo.Collection
 var colCdn *mongo.Collection
 var colMultiDevice *mongo.Collection
+var colIdentityHistory *mongo.Collection
+var colSenderKeyDistribution *mongo.Collection
+var colPoll *mongo.Collection
+var colPollVote *mongo.Collection
+var colChatEphemeral *mongo.Collection
+var colStatusUpdate *mongo.Collection
+var colBroadcastList *mongo.Collection
+var colMsgRecipient *mongo.Collection
+var colSentMessage *mongo.Collection
 
 func init() {
 	colProfile = client.Database(DB_NAME).Collection(`Profile`)
@@ -79,6 +91,15 @@ func init() {
 	colWamEvent = client.Database(DB_NAME).Collection(`WamEvent`)
 	colCdn = client.Database(DB_NAME).Collection(`Cdn`)
 	colMultiDevice = client.Database(DB_NAME).Collection(`MultiDevice`)
+	colIdentityHistory = client.Database(DB_NAME).Collection(`IdentityHistory`)
+	colSenderKeyDistribution = client.Database(DB_NAME).Collection(`SenderKeyDistribution`)
+	colPoll = client.Database(DB_NAME).Collection(`Poll`)
+	colPollVote = client.Database(DB_NAME).Collection(`PollVote`)
+	colChatEphemeral = client.Database(DB_NAME).Collection(`ChatEphemeral`)
+	colStatusUpdate = client.Database(DB_NAME).Collection(`StatusUpdate`)
+	colBroadcastList = client.Database(DB_NAME).Collection(`BroadcastList`)
+	colMsgRecipient = client.Database(DB_NAME).Collection(`MsgRecipient`)
+	colSentMessage = client.Database(DB_NAME).Collection(`SentMessage`)
 
 	_, e1 := colProfile.Indexes().CreateOne(ctx, mongo.IndexModel{
 		Keys: bson.M{"AccId": 1}, Options: options.Index().SetUnique(true)})
@@ -138,6 +159,12 @@ func init() {
 			{Key: "Jid", Value: 1},
 		},
 	})
+	_, e27 := colSentMessage.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "AccId", Value: 1},
+			{Key: "MsgId", Value: 1},
+		},
+	})
 	_, e14 := colWamSchedule.Indexes().CreateOne(ctx, mongo.IndexModel{
 		Keys: bson.M{"AccId": 1}, Options: options.Index().SetUnique(true)})
 	_, e15 := colWamEvent.Indexes().CreateOne(ctx, mongo.IndexModel{
@@ -152,8 +179,61 @@ func init() {
 			{Key: "DeviceId", Value: 1},
 		},
 	})
+	_, e18 := colIdentityHistory.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "AccId", Value: 1},
+			{Key: "RecipientId", Value: 1},
+			{Key: "DeviceId", Value: 1},
+		},
+	})
+	_, e19 := colSenderKeyDistribution.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "AccId", Value: 1},
+			{Key: "GroupId", Value: 1},
+			{Key: "KeyId", Value: 1},
+		},
+	})
+	_, e20 := colPoll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "AccId", Value: 1},
+			{Key: "MsgId", Value: 1},
+		},
+	})
+	_, e21 := colPollVote.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "AccId", Value: 1},
+			{Key: "PollId", Value: 1},
+			{Key: "Voter", Value: 1},
+		},
+	})
+	_, e22 := colChatEphemeral.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "AccId", Value: 1},
+			{Key: "Chat", Value: 1},
+		},
+	})
+	_, e23 := colStatusUpdate.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "AccId", Value: 1},
+			{Key: "MsgId", Value: 1},
+		},
+	})
+	_, e24 := colBroadcastList.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "AccId", Value: 1},
+			{Key: "ListId", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	_, e26 := colMsgRecipient.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "AccId", Value: 1},
+			{Key: "MsgId", Value: 1},
+			{Key: "Jid", Value: 1},
+		},
+	})
 
-	if e1 != nil || e2 != nil || e3 != nil || e4 != nil || e5 != nil || e6 != nil || e7 != nil || e8 != nil || e9 != nil || e10 != nil || e11 != nil || e12 != nil || e13 != nil || e14 != nil || e15 != nil || e16 != nil || e17 != nil {
+	if e1 != nil || e2 != nil || e3 != nil || e4 != nil || e5 != nil || e6 != nil || e7 != nil || e8 != nil || e9 != nil || e10 != nil || e11 != nil || e12 != nil || e13 != nil || e14 != nil || e15 != nil || e16 != nil || e17 != nil || e18 != nil || e19 != nil || e20 != nil || e21 != nil || e22 != nil || e23 != nil || e24 != nil || e26 != nil || e27 != nil {
 		panic(`fail create db index`)
 	}
 }
@@ -181,10 +261,19 @@ func DeleteAcc(acc_id uint64) error {
 	_, e15 := colCdn.DeleteMany(ctx, bson.M{`AccId`: acc_id})
 	_, e16 := colWamEvent.DeleteMany(ctx, bson.M{`AccId`: acc_id})
 	_, e17 := colMultiDevice.DeleteMany(ctx, bson.M{`AccId`: acc_id})
+	_, e19 := colIdentityHistory.DeleteMany(ctx, bson.M{`AccId`: acc_id})
+	_, e20 := colSenderKeyDistribution.DeleteMany(ctx, bson.M{`AccId`: acc_id})
+	_, e21 := colPoll.DeleteMany(ctx, bson.M{`AccId`: acc_id})
+	_, e22 := colPollVote.DeleteMany(ctx, bson.M{`AccId`: acc_id})
+	_, e23 := colChatEphemeral.DeleteMany(ctx, bson.M{`AccId`: acc_id})
+	_, e24 := colStatusUpdate.DeleteMany(ctx, bson.M{`AccId`: acc_id})
+	_, e25 := colBroadcastList.DeleteMany(ctx, bson.M{`AccId`: acc_id})
+	_, e27 := colMsgRecipient.DeleteMany(ctx, bson.M{`AccId`: acc_id})
+	_, e28 := colSentMessage.DeleteMany(ctx, bson.M{`AccId`: acc_id})
 
 	_, e18 := colLog.DeleteMany(ctx, bson.M{`AccId`: acc_id})
 
-	if e1 != nil || e2 != nil || e3 != nil || e4 != nil || e5 != nil || e6 != nil || e7 != nil || e8 != nil || e9 != nil || e10 != nil || e11 != nil || e12 != nil || e13 != nil || e14 != nil || e15 != nil || e16 != nil || e17 != nil || e18 != nil {
+	if e1 != nil || e2 != nil || e3 != nil || e4 != nil || e5 != nil || e6 != nil || e7 != nil || e8 != nil || e9 != nil || e10 != nil || e11 != nil || e12 != nil || e13 != nil || e14 != nil || e15 != nil || e16 != nil || e17 != nil || e18 != nil || e19 != nil || e20 != nil || e21 != nil || e22 != nil || e23 != nil || e24 != nil || e25 != nil || e27 != nil || e28 != nil {
 		return errors.New(`db Delete err`)
 	}
 
@@ -810,22 +899,92 @@ func (s *Store) SetMyIdentityKeyPair(ikp *identity.KeyPair) error {
 	})
 }
 
+func (s *Store) GetIdentity(addr *protocol.SignalAddress) (*def.Identity, error) {
+	recid, e := strconv.Atoi(addr.Name())
+	if e != nil {
+		return nil, e
+	}
+	iden := &def.Identity{}
+	e = colIdentity.FindOne(ctx, bson.M{
+		`AccId`: s.acc_id, `RecipientId`: uint(recid), `DeviceId`: addr.DeviceID(),
+	}).Decode(iden)
+	if e != nil {
+		return nil, e
+	}
+	return iden, nil
+}
+
 // called by Radical
 func (s *Store) SaveIdentity(addr *protocol.SignalAddress, identityKey *identity.Key) error {
 	recid, e := strconv.Atoi(addr.Name())
 	if e != nil {
 		return e
 	}
+	pub := identityKey.PublicKey().PublicKey()
 
 	filter := bson.M{
 		`AccId`: s.acc_id, `RecipientId`: uint(recid), `DeviceId`: addr.DeviceID(),
 	}
-	pub := identityKey.PublicKey().PublicKey()
 	mod := bson.M{
 		`PublicKey`: pub[:],
+		`Changed`:   false,
+	}
+
+	// key changed, keep the old one in history
+	if old, e := s.GetIdentity(addr); e == nil && !bytes.Equal(old.PublicKey, pub[:]) {
+		h, e := s.AddIdentityHistory(
+			uint(recid), addr.DeviceID(), old.PublicKey, pub[:], def.IdentityStatus_Accepted)
+		if e != nil {
+			return e
+		}
+		if s.OnIdentityChange != nil {
+			s.OnIdentityChange(h)
+		}
+		mod[`Verified`] = false
 	}
 	return s.ModifyIdentity(filter, mod)
 }
+
+// overwrite without history, for approving pending identity
+func (s *Store) SaveIdentityKey(addr *protocol.SignalAddress, pub []byte) error {
+	recid, e := strconv.Atoi(addr.Name())
+	if e != nil {
+		return e
+	}
+	return s.ModifyIdentity(bson.M{
+		`AccId`: s.acc_id, `RecipientId`: uint(recid), `DeviceId`: addr.DeviceID(),
+	}, bson.M{
+		`PublicKey`: pub,
+		`Changed`:   false,
+		`Verified`:  false,
+	})
+}
+func (s *Store) SetIdentityVerified(addr *protocol.SignalAddress, verified bool) error {
+	recid, e := strconv.Atoi(addr.Name())
+	if e != nil {
+		return e
+	}
+	_, e = colIdentity.UpdateOne(ctx, bson.M{
+		`AccId`: s.acc_id, `RecipientId`: uint(recid), `DeviceId`: addr.DeviceID(),
+	}, bson.M{
+		`$set`: bson.M{`Verified`: verified},
+	})
+	return e
+}
+
+// peer re-registered, the new key will be accepted on TOFU policy
+func (s *Store) SetIdentityChanged(addr *protocol.SignalAddress) error {
+	recid, e := strconv.Atoi(addr.Name())
+	if e != nil {
+		return e
+	}
+	_, e = colIdentity.UpdateOne(ctx, bson.M{
+		`AccId`: s.acc_id, `RecipientId`: uint(recid), `DeviceId`: addr.DeviceID(),
+	}, bson.M{
+		`$set`: bson.M{`Changed`: true},
+	})
+	return e
+}
 func (s *Store) DeleteIdentity(addr *protocol.SignalAddress) error {
 	recid, e := strconv.Atoi(addr.Name())
 	if e != nil {
@@ -843,11 +1002,8 @@ func (s *Store) IsTrustedIdentity(addr *protocol.SignalAddress, identityKey *ide
 		return false
 	}
 
-	iden := &def.Identity{}
-	e = colIdentity.FindOne(ctx, bson.M{
-		`AccId`: s.acc_id, `RecipientId`: uint(recid), `DeviceId`: addr.DeviceID(),
-	}).Decode(iden)
-	if errors.Is(e, mongo.ErrNoDocuments) {
+	iden, e := s.GetIdentity(addr)
+	if errors.Is(e, mongo.ErrNoDocuments) { // first use
 		return true
 	}
 	if e != nil {
@@ -855,7 +1011,173 @@ func (s *Store) IsTrustedIdentity(addr *protocol.SignalAddress, identityKey *ide
 	}
 
 	pub := identityKey.PublicKey().PublicKey()
-	return bytes.Equal(iden.PublicKey, pub[:])
+	if bytes.Equal(iden.PublicKey, pub[:]) {
+		return true
+	}
+
+	// key changed
+	cfg, e := s.GetConfig()
+	if e != nil {
+		return false
+	}
+	switch cfg.IdentityTrust {
+	case def.IdentityTrust_AlwaysAccept:
+		return true
+	case def.IdentityTrust_BlockUntilApproved:
+		// already pending/rejected
+		h, e := s.GetBlockedIdentity(uint(recid), addr.DeviceID(), pub[:])
+		if e != nil || h != nil {
+			return false
+		}
+		// only notify when it's newly added, this is called on every decryption
+		h, added, e := s.AddPendingIdentity(
+			uint(recid), addr.DeviceID(), iden.PublicKey, pub[:])
+		if e == nil && added && s.OnIdentityChange != nil {
+			s.OnIdentityChange(h)
+		}
+		return false
+	default: // Tofu, only accept after peer's `encrypt` notification
+		return iden.Changed
+	}
+}
+
+func (s *Store) AddIdentityHistory(
+	recid uint, devid uint32,
+	old_key, new_key []byte,
+	status string,
+) (*def.IdentityHistory, error) {
+	now := time.Now()
+	h := &def.IdentityHistory{
+		ID:          primitive.NewObjectID(),
+		AccId:       s.acc_id,
+		RecipientId: recid,
+		DeviceId:    devid,
+		OldKey:      old_key,
+		NewKey:      new_key,
+		Status:      status,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	_, e := colIdentityHistory.InsertOne(ctx, h)
+	if e != nil {
+		return nil, e
+	}
+	return h, nil
+}
+
+// Upsert on (recid, devid, new_key), so concurrent decryptions with the same
+// new key only add one pending history, `added` is false if already exists.
+func (s *Store) AddPendingIdentity(
+	recid uint, devid uint32,
+	old_key, new_key []byte,
+) (*def.IdentityHistory, bool, error) {
+	now := time.Now()
+	id := primitive.NewObjectID()
+	r, e := colIdentityHistory.UpdateOne(ctx, bson.M{
+		`AccId`:       s.acc_id,
+		`RecipientId`: recid,
+		`DeviceId`:    devid,
+		`NewKey`:      new_key,
+		`Status`: bson.M{`$in`: []string{
+			def.IdentityStatus_Pending, def.IdentityStatus_Rejected,
+		}},
+	}, bson.M{
+		`$setOnInsert`: bson.M{
+			`_id`:       id,
+			`OldKey`:    old_key,
+			`Status`:    def.IdentityStatus_Pending,
+			`CreatedAt`: now,
+			`UpdatedAt`: now,
+		},
+	}, options.Update().SetUpsert(true))
+	if e != nil {
+		return nil, false, e
+	}
+	if r.UpsertedCount == 0 {
+		return nil, false, nil
+	}
+	return &def.IdentityHistory{
+		ID:          id,
+		AccId:       s.acc_id,
+		RecipientId: recid,
+		DeviceId:    devid,
+		OldKey:      old_key,
+		NewKey:      new_key,
+		Status:      def.IdentityStatus_Pending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, true, nil
+}
+
+// the pending/rejected history of the key, returns nil if not found
+func (s *Store) GetBlockedIdentity(
+	recid uint, devid uint32, new_key []byte,
+) (*def.IdentityHistory, error) {
+	h := &def.IdentityHistory{}
+	e := colIdentityHistory.FindOne(ctx, bson.M{
+		`AccId`:       s.acc_id,
+		`RecipientId`: recid,
+		`DeviceId`:    devid,
+		`NewKey`:      new_key,
+		`Status`: bson.M{`$in`: []string{
+			def.IdentityStatus_Pending, def.IdentityStatus_Rejected,
+		}},
+	}).Decode(h)
+	if errors.Is(e, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if e != nil {
+		return nil, e
+	}
+	return h, nil
+}
+func (s *Store) GetIdentityHistoryById(id primitive.ObjectID) (*def.IdentityHistory, error) {
+	h := &def.IdentityHistory{}
+	e := colIdentityHistory.FindOne(ctx, bson.M{
+		`_id`: id, `AccId`: s.acc_id,
+	}).Decode(h)
+	if e != nil {
+		return nil, errors.Wrap(e, `fail get identity history`)
+	}
+	return h, nil
+}
+func (s *Store) SetIdentityHistoryStatus(id primitive.ObjectID, status string) error {
+	_, e := colIdentityHistory.UpdateOne(ctx, bson.M{
+		`_id`: id, `AccId`: s.acc_id,
+	}, bson.M{
+		`$set`: bson.M{`Status`: status, `UpdatedAt`: time.Now()},
+	})
+	return e
+}
+
+// filter by status, "" for all
+func (s *Store) ListIdentityHistory(
+	recid uint, devid uint32, status string,
+) ([]*def.IdentityHistory, error) {
+	filter := bson.M{`AccId`: s.acc_id}
+	if recid > 0 {
+		filter[`RecipientId`] = recid
+		filter[`DeviceId`] = devid
+	}
+	if status != `` {
+		filter[`Status`] = status
+	}
+	var hs []*def.IdentityHistory
+
+	cur, e := colIdentityHistory.Find(ctx, filter,
+		options.Find().SetSort(bson.M{`CreatedAt`: 1}))
+	if e != nil {
+		return nil, e
+	}
+	for cur.Next(ctx) {
+		x := &def.IdentityHistory{}
+		e := cur.Decode(x)
+		if e != nil {
+			return nil, e
+		}
+		hs = append(hs, x)
+	}
+	return hs, nil
 }
 
 func (s *Store) SetMyNextPrekeyId(prekey_id uint32) error {
@@ -1032,6 +1354,10 @@ func (s *Store) DeleteSession(addr *protocol.SignalAddress) {
 	colSession.DeleteMany(ctx, bson.M{
 		`AccId`: s.acc_id, `RecipientId`: uint(recid), `DeviceId`: addr.DeviceID(),
 	})
+	// new session, sender keys need to be distributed again
+	colSenderKeyDistribution.DeleteMany(ctx, bson.M{
+		`AccId`: s.acc_id, `RecipientId`: uint(recid), `DeviceId`: addr.DeviceID(),
+	})
 }
 
 func (s *Store) DeleteAllSessions() {
@@ -1084,6 +1410,14 @@ func (s *Store) DeleteSenderKey(addr *protocol.SignalAddress) error {
 	return e
 }
 
+// delete the sender key of one group
+func (s *Store) DeleteGroupSenderKey(skn *protocol.SenderKeyName) error {
+	_, e := colSenderKey.DeleteOne(ctx, bson.M{
+		`AccId`: s.acc_id, `GroupId`: skn.GroupID(), `SenderId`: skn.Sender().Name(), `DeviceId`: skn.Sender().DeviceID(),
+	})
+	return e
+}
+
 // message
 func (s *Store) EnsureMessage(
 	msg_id string,
@@ -1159,6 +1493,192 @@ func (s *Store) SaveDecodedMessage(
 	})
 }
 
+// revoked for everyone, the decrypted content is dropped
+func (s *Store) RevokeMessage(msg_id, by string) error {
+	return s.ModifyMessage(msg_id, bson.M{
+		`Revoked`:   true,
+		`RevokedBy`: by,
+		`DecMedia`:  nil,
+	})
+}
+
+// replace the content with the edited one
+func (s *Store) EditMessage(
+	msg_id string,
+	media_type uint32,
+	media []byte,
+	t int64,
+) error {
+	return s.ModifyMessage(msg_id, bson.M{
+		`Decrypted`: true,
+		`MediaType`: media_type,
+		`DecMedia`:  media,
+		`EditedAt`:  t,
+	})
+}
+
+// view-once media opened, `media` is the content without keys,
+// returns ErrNoDocuments if it's already opened
+func (s *Store) OpenViewOnce(msg_id string, media []byte) error {
+	r, e := colMessage.UpdateOne(ctx, bson.M{
+		`AccId`: s.acc_id, `MsgId`: msg_id,
+		`ViewOnceOpened`: bson.M{`$ne`: true},
+	}, bson.M{
+		`$set`: bson.M{
+			`DecMedia`:       media,
+			`ViewOnceOpened`: true,
+		},
+	})
+	if e != nil {
+		return e
+	}
+	if r.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// revert OpenViewOnce if fail to download it
+func (s *Store) UnopenViewOnce(msg_id string, media []byte) error {
+	return s.ModifyMessage(msg_id, bson.M{
+		`DecMedia`:       media,
+		`ViewOnceOpened`: false,
+	})
+}
+
+// replaces the reaction of `sender` to message `msg_id`,
+// empty `emoji` removes it
+func (s *Store) SetMessageReaction(
+	msg_id, sender, emoji string, t int64,
+) error {
+	filter := bson.M{`AccId`: s.acc_id, `MsgId`: msg_id}
+
+	_, e := colMessage.UpdateOne(ctx, filter, bson.M{
+		`$pull`: bson.M{`Reactions`: bson.M{`Sender`: sender}},
+	})
+	if e != nil {
+		return e
+	}
+	if emoji == `` {
+		return nil
+	}
+	// no upsert, the reaction is only kept if the message is cached
+	_, e = colMessage.UpdateOne(ctx, filter, bson.M{
+		`$push`: bson.M{`Reactions`: &def.MessageReaction{
+			Sender: sender, Emoji: emoji, T: t,
+		}},
+	})
+	return e
+}
+
+// sent plaintext
+func (s *Store) SaveSentMessage(msg_id, chat string, plain []byte) error {
+	_, e := colSentMessage.UpdateOne(ctx, bson.M{
+		`AccId`: s.acc_id, `MsgId`: msg_id,
+	}, bson.M{
+		`$set`: bson.M{
+			`Chat`:      chat,
+			`Plain`:     plain,
+			`CreatedAt`: time.Now(),
+		},
+	}, options.Update().SetUpsert(true))
+	return e
+}
+func (s *Store) GetSentMessage(msg_id string) (*def.SentMessage, error) {
+	sm := &def.SentMessage{}
+	e := colSentMessage.FindOne(ctx, bson.M{
+		`AccId`: s.acc_id, `MsgId`: msg_id,
+	}).Decode(sm)
+	return sm, e
+}
+func (s *Store) DeleteSentMessagesBefore(t time.Time) (int64, error) {
+	r, e := colSentMessage.DeleteMany(ctx, bson.M{
+		`AccId`:     s.acc_id,
+		`CreatedAt`: bson.M{`$lt`: t},
+	})
+	if e != nil {
+		return 0, e
+	}
+	return r.DeletedCount, nil
+}
+
+// recipient devices of sent message
+func (s *Store) AddMsgRecipients(msg_id, chat string, jids []string) error {
+	docs := []any{}
+	for _, jid := range jids {
+		docs = append(docs, bson.M{
+			`AccId`:  s.acc_id,
+			`MsgId`:  msg_id,
+			`Chat`:   chat,
+			`Jid`:    jid,
+			`Status`: uint32(0),
+			`T`:      int64(0),
+		})
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+	_, e := colMsgRecipient.InsertMany(ctx, docs)
+	return e
+}
+
+// status only goes up, eg: `delivered` after `read` is ignored,
+// returns the count of updated records
+func (s *Store) SetMsgRecipientStatus(
+	msg_id, jid string, status uint32, t int64,
+) (int64, error) {
+	r, e := colMsgRecipient.UpdateMany(ctx, bson.M{
+		`AccId`:  s.acc_id,
+		`MsgId`:  msg_id,
+		`Jid`:    jid,
+		`Status`: bson.M{`$lt`: status},
+	}, bson.M{
+		`$set`: bson.M{`Status`: status, `T`: t},
+	})
+	if e != nil {
+		return 0, e
+	}
+	return r.ModifiedCount, nil
+}
+func (s *Store) ListMsgRecipients(msg_id string) ([]*def.MsgRecipient, error) {
+	var ret []*def.MsgRecipient
+
+	cur, e := colMsgRecipient.Find(ctx, bson.M{
+		`AccId`: s.acc_id, `MsgId`: msg_id,
+	})
+	if e != nil {
+		return nil, e
+	}
+	for cur.Next(ctx) {
+		x := &def.MsgRecipient{}
+		if e := cur.Decode(x); e != nil {
+			return nil, e
+		}
+		ret = append(ret, x)
+	}
+	return ret, nil
+}
+
+// disappearing message
+func (s *Store) SetMessageExpireAt(msg_id string, t time.Time) error {
+	return s.ModifyMessage(msg_id, bson.M{
+		`ExpireAt`: t,
+	})
+}
+func (s *Store) DeleteExpiredMessages() (int64, error) {
+	r, e := colMessage.DeleteMany(ctx, bson.M{
+		`AccId`: s.acc_id,
+		`ExpireAt`: bson.M{
+			`$gt`: time.Unix(0, 0),
+			`$lt`: time.Now(),
+		},
+	})
+	if e != nil {
+		return 0, e
+	}
+	return r.DeletedCount, nil
+}
+
 func (s *Store) ListMessages() ([]*def.Message, error) {
 	var ms []*def.Message
 
@@ -1197,8 +1717,226 @@ func (s *Store) DeleteMessages(
 	}
 	return nil
 }
+
+// chat ephemeral
+func (s *Store) SetChatEphemeral(
+	chat string, expiration uint32, setting_ts int64,
+) error {
+	_, e := colChatEphemeral.UpdateOne(ctx, bson.M{
+		`AccId`: s.acc_id, `Chat`: chat,
+	}, bson.M{
+		`$set`: bson.M{
+			`Expiration`:       expiration,
+			`SettingTimestamp`: setting_ts,
+		},
+	}, options.Update().SetUpsert(true))
+	return e
+}
+
+// returns Expiration 0 if never set
+func (s *Store) GetChatEphemeral(chat string) (*def.ChatEphemeral, error) {
+	ce := &def.ChatEphemeral{}
+	e := colChatEphemeral.FindOne(ctx, bson.M{
+		`AccId`: s.acc_id, `Chat`: chat,
+	}).Decode(ce)
+	if errors.Is(e, mongo.ErrNoDocuments) {
+		return &def.ChatEphemeral{Chat: chat}, nil
+	}
+	return ce, e
+}
+
+// status updates of contacts
+func (s *Store) SaveStatusUpdate(su *def.StatusUpdate) error {
+	_, e := colStatusUpdate.UpdateOne(ctx, bson.M{
+		`AccId`: s.acc_id, `MsgId`: su.MsgId,
+	}, bson.M{
+		`$set`: bson.M{
+			`Sender`:    su.Sender,
+			`MediaType`: su.MediaType,
+			`DecMedia`:  su.DecMedia,
+			`T`:         su.T,
+		},
+	}, options.Update().SetUpsert(true))
+	return e
+}
+func (s *Store) GetStatusUpdate(msg_id string) (*def.StatusUpdate, error) {
+	su := &def.StatusUpdate{}
+	e := colStatusUpdate.FindOne(ctx, bson.M{
+		`AccId`: s.acc_id, `MsgId`: msg_id,
+	}).Decode(su)
+	return su, e
+}
+
+// posted after `since`, of all contacts if `sender` is empty
+func (s *Store) ListStatusUpdates(
+	sender string, since int64,
+) ([]*def.StatusUpdate, error) {
+	var ret []*def.StatusUpdate
+
+	filter := bson.M{
+		`AccId`: s.acc_id,
+		`T`:     bson.M{`$gte`: since},
+	}
+	if sender != `` {
+		filter[`Sender`] = sender
+	}
+	cur, e := colStatusUpdate.Find(ctx, filter,
+		options.Find().SetSort(bson.M{`T`: 1}))
+	if e != nil {
+		return nil, e
+	}
+	for cur.Next(ctx) {
+		x := &def.StatusUpdate{}
+		if e := cur.Decode(x); e != nil {
+			return nil, e
+		}
+		ret = append(ret, x)
+	}
+	return ret, nil
+}
+func (s *Store) SetStatusUpdateRead(msg_id string) error {
+	_, e := colStatusUpdate.UpdateOne(ctx, bson.M{
+		`AccId`: s.acc_id, `MsgId`: msg_id,
+	}, bson.M{
+		`$set`: bson.M{`Read`: true},
+	})
+	return e
+}
+func (s *Store) DeleteStatusUpdatesBefore(t int64) (int64, error) {
+	r, e := colStatusUpdate.DeleteMany(ctx, bson.M{
+		`AccId`: s.acc_id,
+		`T`:     bson.M{`$lt`: t},
+	})
+	if e != nil {
+		return 0, e
+	}
+	return r.DeletedCount, nil
+}
+
+// broadcast list
+func (s *Store) CreateBroadcastList(bl *def.BroadcastList) error {
+	_, e := colBroadcastList.InsertOne(ctx, bson.M{
+		`AccId`:      s.acc_id,
+		`ListId`:     bl.ListId,
+		`Name`:       bl.Name,
+		`Recipients`: bl.Recipients,
+	})
+	return e
+}
+func (s *Store) GetBroadcastList(list_id string) (*def.BroadcastList, error) {
+	bl := &def.BroadcastList{}
+	e := colBroadcastList.FindOne(ctx, bson.M{
+		`AccId`: s.acc_id, `ListId`: list_id,
+	}).Decode(bl)
+	return bl, e
+}
+func (s *Store) ListBroadcastLists() ([]*def.BroadcastList, error) {
+	var ret []*def.BroadcastList
+
+	cur, e := colBroadcastList.Find(ctx, bson.M{`AccId`: s.acc_id})
+	if e != nil {
+		return nil, e
+	}
+	for cur.Next(ctx) {
+		x := &def.BroadcastList{}
+		if e := cur.Decode(x); e != nil {
+			return nil, e
+		}
+		ret = append(ret, x)
+	}
+	return ret, nil
+}
+func (s *Store) ModifyBroadcastList(list_id string, mod bson.M) error {
+	r, e := colBroadcastList.UpdateOne(ctx, bson.M{
+		`AccId`: s.acc_id, `ListId`: list_id,
+	}, bson.M{
+		`$set`: mod,
+	})
+	if e != nil {
+		return e
+	}
+	if r.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// also deletes the recipient records of messages sent to it
+func (s *Store) DeleteBroadcastList(list_id string) error {
+	r, e := colBroadcastList.DeleteOne(ctx, bson.M{
+		`AccId`: s.acc_id, `ListId`: list_id,
+	})
+	if e != nil {
+		return e
+	}
+	if r.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	_, e = colMsgRecipient.DeleteMany(ctx, bson.M{
+		`AccId`: s.acc_id, `Chat`: list_id,
+	})
+	return e
+}
+
+// poll
+func (s *Store) SavePoll(p *def.Poll) error {
+	_, e := colPoll.UpdateOne(ctx, bson.M{
+		`AccId`: s.acc_id, `MsgId`: p.MsgId,
+	}, bson.M{
+		`$set`: bson.M{
+			`Chat`:            p.Chat,
+			`Creator`:         p.Creator,
+			`Secret`:          p.Secret,
+			`Name`:            p.Name,
+			`Options`:         p.Options,
+			`SelectableCount`: p.SelectableCount,
+		},
+	}, options.Update().SetUpsert(true))
+	return e
+}
+func (s *Store) GetPoll(msg_id string) (*def.Poll, error) {
+	p := &def.Poll{}
+	e := colPoll.FindOne(ctx, bson.M{
+		`AccId`: s.acc_id, `MsgId`: msg_id,
+	}).Decode(p)
+	return p, e
+}
+
+// replaces the previous vote of `voter`
+func (s *Store) SetPollVote(
+	poll_id, voter string, selected [][]byte, t int64,
+) error {
+	_, e := colPollVote.UpdateOne(ctx, bson.M{
+		`AccId`: s.acc_id, `PollId`: poll_id, `Voter`: voter,
+	}, bson.M{
+		`$set`: bson.M{
+			`Selected`: selected,
+			`T`:        t,
+		},
+	}, options.Update().SetUpsert(true))
+	return e
+}
+func (s *Store) ListPollVotes(poll_id string) ([]*def.PollVote, error) {
+	var ret []*def.PollVote
+
+	cur, e := colPollVote.Find(ctx, bson.M{
+		`AccId`: s.acc_id, `PollId`: poll_id,
+	})
+	if e != nil {
+		return nil, e
+	}
+	for cur.Next(ctx) {
+		x := &def.PollVote{}
+		if e := cur.Decode(x); e != nil {
+			return nil, e
+		}
+		ret = append(ret, x)
+	}
+	return ret, nil
+}
+
 func (s *Store) CreateGroup(
-	gid, subject, creator string, members []string,
+	gid, subject, creator string, members, admins []string,
 ) error {
 	// 1. store group
 	r := colGroup.FindOneAndUpdate(ctx, bson.M{
@@ -1218,9 +1956,14 @@ func (s *Store) CreateGroup(
 		return e
 	}
 	// 2. store members
+	is_admin := map[string]bool{}
+	for _, jid := range admins {
+		is_admin[jid] = true
+	}
 	for _, jid := range members {
 		_, e := colGroupMember.InsertOne(ctx, bson.M{
 			`AccId`: s.acc_id, `GroupId`: g.ID, `Jid`: jid,
+			`Admin`: is_admin[jid],
 		})
 		if e != nil {
 			return e
@@ -1304,6 +2047,34 @@ func (s *Store) AddGroupMember(gid, jid string) error {
 	})
 	return e
 }
+
+// promote/demote members
+func (s *Store) SetGroupAdmin(gid string, jids []string, admin bool) error {
+	id, e := s.find_group_id(gid)
+	if e != nil {
+		return e
+	}
+	_, e = colGroupMember.UpdateMany(ctx, bson.M{
+		`AccId`: s.acc_id, `GroupId`: id, `Jid`: bson.M{`$in`: jids},
+	}, bson.M{
+		`$set`: bson.M{`Admin`: admin},
+	})
+	return e
+}
+func (s *Store) IsGroupAdmin(gid, jid string) (bool, error) {
+	id, e := s.find_group_id(gid)
+	if e != nil {
+		return false, e
+	}
+	gm := &def.GroupMember{}
+	e = colGroupMember.FindOne(ctx, bson.M{
+		`AccId`: s.acc_id, `GroupId`: id, `Jid`: jid,
+	}).Decode(gm)
+	if e != nil {
+		return false, e
+	}
+	return gm.Admin, nil
+}
 func (s *Store) ListGroupMember(gid string, include_self bool) ([]*def.GroupMember, error) {
 	if gid == `status@broadcast` { // sns
 		return []*def.GroupMember{}, nil // TODO, log usync to db
@@ -1353,6 +2124,73 @@ func (s *Store) ListGroupMemberJid(gid string, include_self bool) ([]string, err
 
 	return jids, nil
 }
+
+// devices that already have our sender key `key_id` of the group
+func (s *Store) ListSenderKeyDistribution(gid string, key_id uint32) ([]*def.SenderKeyDistribution, error) {
+	cur, e := colSenderKeyDistribution.Find(ctx, bson.M{
+		`AccId`: s.acc_id, `GroupId`: gid, `KeyId`: key_id,
+	})
+	if e != nil {
+		return nil, e
+	}
+	var ds []*def.SenderKeyDistribution
+	for cur.Next(ctx) {
+		d := &def.SenderKeyDistribution{}
+		e := cur.Decode(d)
+		if e != nil {
+			return nil, e
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+func (s *Store) AddSenderKeyDistribution(
+	gid string, key_id uint32, recid uint, devid uint32,
+) error {
+	r := colSenderKeyDistribution.FindOneAndUpdate(ctx, bson.M{
+		`AccId`: s.acc_id, `GroupId`: gid, `KeyId`: key_id, `RecipientId`: recid, `DeviceId`: devid,
+	}, bson.M{
+		`$set`: bson.M{`KeyId`: key_id},
+	}, options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After))
+	return r.Err()
+}
+
+// called when sender key rotated
+func (s *Store) DeleteSenderKeyDistribution(gid string) error {
+	_, e := colSenderKeyDistribution.DeleteMany(ctx, bson.M{
+		`AccId`: s.acc_id, `GroupId`: gid,
+	})
+	return e
+}
+
+// all groups that the member is in
+func (s *Store) ListGroupIdByMember(jid string) ([]string, error) {
+	ids, e := colGroupMember.Distinct(ctx, `GroupId`, bson.M{
+		`AccId`: s.acc_id, `Jid`: jid,
+	})
+	if e != nil {
+		return nil, e
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	cur, e := colGroup.Find(ctx, bson.M{
+		`AccId`: s.acc_id, `_id`: bson.M{`$in`: ids},
+	})
+	if e != nil {
+		return nil, e
+	}
+	var gids []string
+	for cur.Next(ctx) {
+		g := &def.Group{}
+		e := cur.Decode(g)
+		if e != nil {
+			return nil, e
+		}
+		gids = append(gids, g.Gid)
+	}
+	return gids, nil
+}
 func (s *Store) GetWamSchedule() (*def.WamSchedule, error) {
 	sch := &def.WamSchedule{}
 
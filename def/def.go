@@ -10,6 +10,9 @@ import (
 */
 var RpcPort int = 3423
 
+// the `path` param of media rpc is restricted to this dir
+var MediaDir = "media"
+
 var PKG_NAME_psnl = "com.whatsapp"
 var PKG_NAME_biz = "com.whatsapp.w4b"
 
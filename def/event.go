@@ -21,4 +21,6 @@ const (
 	Ev_receipt      = "receipt"
 	Ev_ib           = "ib"
 	Ev_notification = "notification"
+	Ev_presence     = "presence"
+	Ev_chatstate    = "chatstate"
 )
@@ -0,0 +1,16 @@
+package def
+
+// how to handle peer identity change
+const (
+	IdentityTrust_Tofu               int8 = 0 // default, accept new key after peer's `encrypt` notification
+	IdentityTrust_BlockUntilApproved int8 = 1 // block the new key until approved by client
+	IdentityTrust_AlwaysAccept       int8 = 2 // accept any new key
+)
+
+// IdentityHistory.Status
+const (
+	IdentityStatus_Accepted = `accepted` // auto accepted by policy
+	IdentityStatus_Pending  = `pending`  // waiting for approve
+	IdentityStatus_Approved = `approved`
+	IdentityStatus_Rejected = `rejected`
+)
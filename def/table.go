@@ -78,6 +78,8 @@ type Config struct {
 
 	RoutingInfo []byte
 
+	IdentityTrust int8 // IdentityTrust_xxx, how to handle peer identity change
+
 	VNameCert []byte
 
 	ConnectionLC int // +1 on every login
@@ -88,6 +90,8 @@ type Config struct {
 	ServerPropsConfigKey string // "0,CY,2J,3c"
 	ServerPropsHash      string // "1xz0eE"
 
+	DisappearingMode uint32 // account default ephemeral duration, in seconds
+
 	// Noise
 	NoiseLocation string
 	StaticPub     []byte
@@ -169,6 +173,25 @@ type Identity struct {
 	PublicKey    []byte
 	PrivateKey   []byte
 	NextPrekeyId uint32
+
+	Changed  bool // peer re-registered, new key not received yet
+	Verified bool // safety number verified, reset when key changes
+}
+
+// old/new identity keys of peers
+type IdentityHistory struct {
+	ID primitive.ObjectID `bson:"_id"`
+
+	AccId       uint64
+	RecipientId uint
+	DeviceId    uint32
+
+	OldKey []byte
+	NewKey []byte
+	Status string // IdentityStatus_xxx
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type SignedPrekey struct {
@@ -190,6 +213,66 @@ type SenderKey struct {
 	Record []byte
 }
 
+// the device that already received our current sender key of the group
+type SenderKeyDistribution struct {
+	ID primitive.ObjectID `bson:"_id"`
+
+	AccId       uint64
+	GroupId     string
+	KeyId       uint32 // id of our sender key
+	RecipientId uint
+	DeviceId    uint32
+}
+
+// poll created by us or received, for decrypting votes
+type Poll struct {
+	ID primitive.ObjectID `bson:"_id"`
+
+	AccId   uint64
+	MsgId   string
+	Chat    string // jid or gid
+	Creator string
+	Secret  []byte // message secret
+
+	Name            string
+	Options         []string
+	SelectableCount uint32
+}
+
+// the latest vote of a voter, replaced by each new vote
+type PollVote struct {
+	ID primitive.ObjectID `bson:"_id"`
+
+	AccId    uint64
+	PollId   string // MsgId of the poll
+	Voter    string
+	Selected [][]byte // sha256 of option names, empty for removal
+	T        int64    // sender timestamp in ms
+}
+
+// status (story) posted by a contact
+type StatusUpdate struct {
+	ID primitive.ObjectID `bson:"_id"`
+
+	AccId     uint64
+	MsgId     string
+	Sender    string
+	MediaType uint32
+	DecMedia  []byte
+	T         int64 // seconds
+	Read      bool  // read receipt sent
+}
+
+// disappearing messages timer of a chat
+type ChatEphemeral struct {
+	ID primitive.ObjectID `bson:"_id"`
+
+	AccId            uint64
+	Chat             string // jid or gid
+	Expiration       uint32 // seconds, 0 for off
+	SettingTimestamp int64
+}
+
 type Proxy struct {
 	ID primitive.ObjectID `bson:"_id"`
 
@@ -215,8 +298,48 @@ type Message struct {
 	RetryTimes    uint32
 	RetryPrekeyId uint32
 
+	// reactions to this message, one per sender,
+	// reactions to messages not in cache are only pushed
+	Reactions []MessageReaction
+
+	Revoked   bool
+	RevokedBy string // sender or group admin
+	EditedAt  int64  // ms, 0 if never edited
+
+	ViewOnceOpened bool // media keys are purged after opened
+
+	ExpireAt time.Time // disappearing message, purged after this
+
 	UpdatedAt time.Time
 }
+type MessageReaction struct {
+	Sender string
+	Emoji  string
+	T      int64 // sender timestamp in ms
+}
+
+// plaintext of a message we sent, for re-sending on retry receipt
+type SentMessage struct {
+	ID primitive.ObjectID `bson:"_id"`
+
+	AccId     uint64
+	MsgId     string
+	Chat      string
+	Plain     []byte // marshaled Message.proto, without padding
+	CreatedAt time.Time
+}
+
+// a recipient device of a message we sent, updated by its receipts
+type MsgRecipient struct {
+	ID primitive.ObjectID `bson:"_id"`
+
+	AccId  uint64
+	MsgId  string
+	Chat   string
+	Jid    string // with device, eg: 111.0:2@s.whatsapp.net
+	Status uint32 // 0: sent, 1: delivered, 2: read, 3: played
+	T      int64  // timestamp of the latest receipt
+}
 
 type Group struct {
 	ID primitive.ObjectID `bson:"_id"`
@@ -227,12 +350,24 @@ type Group struct {
 	Creator string
 	Subject string
 }
+
+// broadcast list, messages are fanned out to each recipient
+type BroadcastList struct {
+	ID primitive.ObjectID `bson:"_id"`
+
+	AccId      uint64
+	ListId     string // 1600000000@broadcast
+	Name       string
+	Recipients []string
+}
+
 type GroupMember struct {
 	ID primitive.ObjectID `bson:"_id"`
 
 	AccId   uint64
 	GroupId string
 	Jid     string
+	Admin   bool
 }
 type WamSchedule struct {
 	ID primitive.ObjectID `bson:"_id"`
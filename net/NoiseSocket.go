@@ -15,6 +15,7 @@ import (
 	"wa/def"
 	"wa/noise"
 	"wa/pb"
+	"wa/stanza"
 	"wa/xmpp"
 
 	"github.com/fanliao/go-promise"
@@ -558,11 +559,17 @@ func (this *NoiseSocket) StartReading() {
 			 eg: ping response is ignored to client
 			 Note: n maybe modified
 			*/
-			ev := this.Event.Fire(n.Tag, n) // trigger hooks
-			if errors.Is(ev, event.Stop) {  // if killed by hook, not push to client
+			st, e := stanza.Decode(n)
+			if e != nil {
+				this.Event.Fire(def.Ev_Log, db.WARNING, `fail decode stanza: `+e.Error())
+				st = stanza.NewRaw(n)
+			}
+
+			ev := this.Event.Fire(n.Tag, st) // trigger hooks
+			if errors.Is(ev, event.Stop) {   // if killed by hook, not push to client
 				//this.Log.Debugf("Node processed by hook: %s", n.ToString())
 			} else {
-				this.Event.Fire(`push`, n)
+				this.Event.Fire(def.Ev_Push, st)
 			}
 		}
 	}
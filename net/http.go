@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"strings"
 	"time"
@@ -40,6 +41,65 @@ func HttpReq(
 	post_body []byte,
 ) (int, []byte, error) {
 
+	status, body, e := HttpReqStream(
+		method, host, url_,
+		proxyAddr, dns, ja3_str,
+		hdr,
+		bytes.NewReader(post_body), int64(len(post_body)),
+	)
+	if e != nil {
+		return 0, nil, e
+	}
+
+	defer body.Close()
+	bs, e := ioutil.ReadAll(body)
+	if e != nil {
+		return 0, nil, e
+	}
+
+	return status, bs, nil
+}
+
+// cancel the request if no data transferred for NET_TIMEOUT
+type idle_timer struct {
+	timer *time.Timer
+}
+
+func (t *idle_timer) touch() {
+	t.timer.Reset(time.Duration(def.NET_TIMEOUT) * time.Second)
+}
+
+type idle_reader struct {
+	io.Reader
+	t *idle_timer
+}
+
+func (r *idle_reader) Read(p []byte) (int, error) {
+	n, e := r.Reader.Read(p)
+	r.t.touch()
+	return n, e
+}
+
+type idle_read_closer struct {
+	idle_reader
+	closer io.Closer
+}
+
+func (r *idle_read_closer) Close() error {
+	r.t.timer.Stop()
+	return r.closer.Close()
+}
+
+// Same as HttpReq, but the request/response body are streamed,
+// for huge files like video/document.
+// The returned body must be closed by caller.
+func HttpReqStream(
+	method, host, url_,
+	proxyAddr string, dns map[string]string, ja3_str string,
+	hdr fhttp.Header,
+	post_body io.Reader, content_length int64,
+) (int, io.ReadCloser, error) {
+
 	if ja3_str == `` {
 		ja3_str = def.Ja3_WhiteMi6x
 	}
@@ -67,10 +127,11 @@ func HttpReq(
 		Transport: ja3_transport,
 	}
 
-	// timeout
+	// timeout, reset on every read/write
 	ctx, cancel := context.WithCancel(context.TODO())
-	timer := time.AfterFunc(time.Duration(def.NET_TIMEOUT)*time.Second, cancel)
-	defer timer.Stop()
+	timer := &idle_timer{
+		timer: time.AfterFunc(time.Duration(def.NET_TIMEOUT)*time.Second, cancel),
+	}
 
 	host_modified := host
 
@@ -81,13 +142,23 @@ func HttpReq(
 		}
 	}
 
+	// empty body must be nil, otherwise it's sent as chunked
+	var body io.Reader
+	if post_body != nil && content_length != 0 {
+		body = &idle_reader{post_body, timer}
+	}
+
 	// for debugging
-	//req, e := fhttp.NewRequest(method, "http://"+host_modified+url_, bytes.NewReader(post_body))
-	req, e := fhttp.NewRequest(method, "https://"+host_modified+url_, bytes.NewReader(post_body))
+	//req, e := fhttp.NewRequest(method, "http://"+host_modified+url_, body)
+	req, e := fhttp.NewRequest(method, "https://"+host_modified+url_, body)
 	if e != nil {
+		timer.timer.Stop()
 		return 0, nil, e
 	}
 	req = req.WithContext(ctx)
+	if body != nil {
+		req.ContentLength = content_length
+	}
 
 	// force http header.Host, if changed by dns
 	//if host != host_modified {
@@ -98,16 +169,15 @@ func HttpReq(
 
 	resp, e := client.Do(req)
 	if e != nil {
+		timer.timer.Stop()
 		return 0, nil, e
 	}
+	timer.touch()
 
-	defer resp.Body.Close()
-	body, e := ioutil.ReadAll(resp.Body)
-	if e != nil {
-		return 0, nil, e
-	}
-
-	return resp.StatusCode, body, nil
+	return resp.StatusCode, &idle_read_closer{
+		idle_reader: idle_reader{resp.Body, timer},
+		closer:      resp.Body,
+	}, nil
 }
 
 func HttpGet(host, url_, proxyAddr string, dns map[string]string, ja3_str string, hdr fhttp.Header) (int, []byte, error) {
@@ -116,3 +186,20 @@ func HttpGet(host, url_, proxyAddr string, dns map[string]string, ja3_str string
 func HttpPost(host, url_, proxyAddr string, dns map[string]string, ja3_str string, hdr fhttp.Header, body []byte) (int, []byte, error) {
 	return HttpReq(`POST`, host, url_, proxyAddr, dns, ja3_str, hdr, body)
 }
+
+// Response body must be closed by caller.
+func HttpGetStream(host, url_, proxyAddr string, dns map[string]string, ja3_str string, hdr fhttp.Header) (int, io.ReadCloser, error) {
+	return HttpReqStream(`GET`, host, url_, proxyAddr, dns, ja3_str, hdr, nil, 0)
+}
+func HttpPostStream(host, url_, proxyAddr string, dns map[string]string, ja3_str string, hdr fhttp.Header, body io.Reader, content_length int64) (int, []byte, error) {
+	status, rc, e := HttpReqStream(`POST`, host, url_, proxyAddr, dns, ja3_str, hdr, body, content_length)
+	if e != nil {
+		return 0, nil, e
+	}
+	defer rc.Close()
+	bs, e := ioutil.ReadAll(rc)
+	if e != nil {
+		return 0, nil, e
+	}
+	return status, bs, nil
+}
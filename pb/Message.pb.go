@@ -20,20 +20,318 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ProtocolMessage_Type int32
+
+const (
+	ProtocolMessage_REVOKE            ProtocolMessage_Type = 0
+	ProtocolMessage_EPHEMERAL_SETTING ProtocolMessage_Type = 3
+	ProtocolMessage_MESSAGE_EDIT      ProtocolMessage_Type = 14
+)
+
+// Enum value maps for ProtocolMessage_Type.
+var (
+	ProtocolMessage_Type_name = map[int32]string{
+		0:  "REVOKE",
+		3:  "EPHEMERAL_SETTING",
+		14: "MESSAGE_EDIT",
+	}
+	ProtocolMessage_Type_value = map[string]int32{
+		"REVOKE":            0,
+		"EPHEMERAL_SETTING": 3,
+		"MESSAGE_EDIT":      14,
+	}
+)
+
+func (x ProtocolMessage_Type) Enum() *ProtocolMessage_Type {
+	p := new(ProtocolMessage_Type)
+	*p = x
+	return p
+}
+
+func (x ProtocolMessage_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProtocolMessage_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_Message_proto_enumTypes[0].Descriptor()
+}
+
+func (ProtocolMessage_Type) Type() protoreflect.EnumType {
+	return &file_Message_proto_enumTypes[0]
+}
+
+func (x ProtocolMessage_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ProtocolMessage_Type) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ProtocolMessage_Type(num)
+	return nil
+}
+
+// Deprecated: Use ProtocolMessage_Type.Descriptor instead.
+func (ProtocolMessage_Type) EnumDescriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type ButtonsMessage_HeaderType int32
+
+const (
+	ButtonsMessage_UNKNOWN ButtonsMessage_HeaderType = 0
+	ButtonsMessage_EMPTY   ButtonsMessage_HeaderType = 1
+	ButtonsMessage_TEXT    ButtonsMessage_HeaderType = 2
+)
+
+// Enum value maps for ButtonsMessage_HeaderType.
+var (
+	ButtonsMessage_HeaderType_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "EMPTY",
+		2: "TEXT",
+	}
+	ButtonsMessage_HeaderType_value = map[string]int32{
+		"UNKNOWN": 0,
+		"EMPTY":   1,
+		"TEXT":    2,
+	}
+)
+
+func (x ButtonsMessage_HeaderType) Enum() *ButtonsMessage_HeaderType {
+	p := new(ButtonsMessage_HeaderType)
+	*p = x
+	return p
+}
+
+func (x ButtonsMessage_HeaderType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ButtonsMessage_HeaderType) Descriptor() protoreflect.EnumDescriptor {
+	return file_Message_proto_enumTypes[1].Descriptor()
+}
+
+func (ButtonsMessage_HeaderType) Type() protoreflect.EnumType {
+	return &file_Message_proto_enumTypes[1]
+}
+
+func (x ButtonsMessage_HeaderType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ButtonsMessage_HeaderType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ButtonsMessage_HeaderType(num)
+	return nil
+}
+
+// Deprecated: Use ButtonsMessage_HeaderType.Descriptor instead.
+func (ButtonsMessage_HeaderType) EnumDescriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type ButtonsMessage_Button_Type int32
+
+const (
+	ButtonsMessage_Button_UNKNOWN  ButtonsMessage_Button_Type = 0
+	ButtonsMessage_Button_RESPONSE ButtonsMessage_Button_Type = 1
+)
+
+// Enum value maps for ButtonsMessage_Button_Type.
+var (
+	ButtonsMessage_Button_Type_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "RESPONSE",
+	}
+	ButtonsMessage_Button_Type_value = map[string]int32{
+		"UNKNOWN":  0,
+		"RESPONSE": 1,
+	}
+)
+
+func (x ButtonsMessage_Button_Type) Enum() *ButtonsMessage_Button_Type {
+	p := new(ButtonsMessage_Button_Type)
+	*p = x
+	return p
+}
+
+func (x ButtonsMessage_Button_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ButtonsMessage_Button_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_Message_proto_enumTypes[2].Descriptor()
+}
+
+func (ButtonsMessage_Button_Type) Type() protoreflect.EnumType {
+	return &file_Message_proto_enumTypes[2]
+}
+
+func (x ButtonsMessage_Button_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ButtonsMessage_Button_Type) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ButtonsMessage_Button_Type(num)
+	return nil
+}
+
+// Deprecated: Use ButtonsMessage_Button_Type.Descriptor instead.
+func (ButtonsMessage_Button_Type) EnumDescriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{19, 0, 0}
+}
+
+type ButtonsResponseMessage_Type int32
+
+const (
+	ButtonsResponseMessage_UNKNOWN      ButtonsResponseMessage_Type = 0
+	ButtonsResponseMessage_DISPLAY_TEXT ButtonsResponseMessage_Type = 1
+)
+
+// Enum value maps for ButtonsResponseMessage_Type.
+var (
+	ButtonsResponseMessage_Type_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "DISPLAY_TEXT",
+	}
+	ButtonsResponseMessage_Type_value = map[string]int32{
+		"UNKNOWN":      0,
+		"DISPLAY_TEXT": 1,
+	}
+)
+
+func (x ButtonsResponseMessage_Type) Enum() *ButtonsResponseMessage_Type {
+	p := new(ButtonsResponseMessage_Type)
+	*p = x
+	return p
+}
+
+func (x ButtonsResponseMessage_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ButtonsResponseMessage_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_Message_proto_enumTypes[3].Descriptor()
+}
+
+func (ButtonsResponseMessage_Type) Type() protoreflect.EnumType {
+	return &file_Message_proto_enumTypes[3]
+}
+
+func (x ButtonsResponseMessage_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ButtonsResponseMessage_Type) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ButtonsResponseMessage_Type(num)
+	return nil
+}
+
+// Deprecated: Use ButtonsResponseMessage_Type.Descriptor instead.
+func (ButtonsResponseMessage_Type) EnumDescriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{20, 0}
+}
+
+type ListMessage_ListType int32
+
+const (
+	ListMessage_UNKNOWN       ListMessage_ListType = 0
+	ListMessage_SINGLE_SELECT ListMessage_ListType = 1
+)
+
+// Enum value maps for ListMessage_ListType.
+var (
+	ListMessage_ListType_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "SINGLE_SELECT",
+	}
+	ListMessage_ListType_value = map[string]int32{
+		"UNKNOWN":       0,
+		"SINGLE_SELECT": 1,
+	}
+)
+
+func (x ListMessage_ListType) Enum() *ListMessage_ListType {
+	p := new(ListMessage_ListType)
+	*p = x
+	return p
+}
+
+func (x ListMessage_ListType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListMessage_ListType) Descriptor() protoreflect.EnumDescriptor {
+	return file_Message_proto_enumTypes[4].Descriptor()
+}
+
+func (ListMessage_ListType) Type() protoreflect.EnumType {
+	return &file_Message_proto_enumTypes[4]
+}
+
+func (x ListMessage_ListType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ListMessage_ListType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ListMessage_ListType(num)
+	return nil
+}
+
+// Deprecated: Use ListMessage_ListType.Descriptor instead.
+func (ListMessage_ListType) EnumDescriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{21, 0}
+}
+
 type Media_Type int32
 
 const (
-	Media_Unknown       Media_Type = 0
-	Media_Text          Media_Type = 1
-	Media_Skdm          Media_Type = 2
-	Media_Image         Media_Type = 3
-	Media_Contact       Media_Type = 4
-	Media_Url           Media_Type = 6
-	Media_Document      Media_Type = 7
-	Media_Ptt           Media_Type = 8
-	Media_Video         Media_Type = 9
-	Media_Contact_Array Media_Type = 13
-	Media_Sticker       Media_Type = 26
+	Media_Unknown          Media_Type = 0
+	Media_Text             Media_Type = 1
+	Media_Skdm             Media_Type = 2
+	Media_Image            Media_Type = 3
+	Media_Contact          Media_Type = 4
+	Media_Location         Media_Type = 5
+	Media_Url              Media_Type = 6
+	Media_Document         Media_Type = 7
+	Media_Ptt              Media_Type = 8
+	Media_Video            Media_Type = 9
+	Media_Protocol         Media_Type = 12
+	Media_Contact_Array    Media_Type = 13
+	Media_LiveLocation     Media_Type = 18
+	Media_Template         Media_Type = 25
+	Media_Sticker          Media_Type = 26
+	Media_Template_Reply   Media_Type = 29
+	Media_List             Media_Type = 36
+	Media_List_Response    Media_Type = 39
+	Media_Buttons          Media_Type = 42
+	Media_Buttons_Response Media_Type = 43
+	Media_Poll_Creation    Media_Type = 49
+	Media_Poll_Update      Media_Type = 50
+	Media_Reaction         Media_Type = 46
 )
 
 // Enum value maps for Media_Type.
@@ -44,25 +342,49 @@ var (
 		2:  "Skdm",
 		3:  "Image",
 		4:  "Contact",
+		5:  "Location",
 		6:  "Url",
 		7:  "Document",
 		8:  "Ptt",
 		9:  "Video",
+		12: "Protocol",
 		13: "Contact_Array",
+		18: "LiveLocation",
+		25: "Template",
 		26: "Sticker",
+		29: "Template_Reply",
+		36: "List",
+		39: "List_Response",
+		42: "Buttons",
+		43: "Buttons_Response",
+		49: "Poll_Creation",
+		50: "Poll_Update",
+		46: "Reaction",
 	}
 	Media_Type_value = map[string]int32{
-		"Unknown":       0,
-		"Text":          1,
-		"Skdm":          2,
-		"Image":         3,
-		"Contact":       4,
-		"Url":           6,
-		"Document":      7,
-		"Ptt":           8,
-		"Video":         9,
-		"Contact_Array": 13,
-		"Sticker":       26,
+		"Unknown":          0,
+		"Text":             1,
+		"Skdm":             2,
+		"Image":            3,
+		"Contact":          4,
+		"Location":         5,
+		"Url":              6,
+		"Document":         7,
+		"Ptt":              8,
+		"Video":            9,
+		"Protocol":         12,
+		"Contact_Array":    13,
+		"LiveLocation":     18,
+		"Template":         25,
+		"Sticker":          26,
+		"Template_Reply":   29,
+		"List":             36,
+		"List_Response":    39,
+		"Buttons":          42,
+		"Buttons_Response": 43,
+		"Poll_Creation":    49,
+		"Poll_Update":      50,
+		"Reaction":         46,
 	}
 )
 
@@ -77,11 +399,11 @@ func (x Media_Type) String() string {
 }
 
 func (Media_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_Message_proto_enumTypes[0].Descriptor()
+	return file_Message_proto_enumTypes[5].Descriptor()
 }
 
 func (Media_Type) Type() protoreflect.EnumType {
-	return &file_Message_proto_enumTypes[0]
+	return &file_Message_proto_enumTypes[5]
 }
 
 func (x Media_Type) Number() protoreflect.EnumNumber {
@@ -100,7 +422,7 @@ func (x *Media_Type) UnmarshalJSON(b []byte) error {
 
 // Deprecated: Use Media_Type.Descriptor instead.
 func (Media_Type) EnumDescriptor() ([]byte, []int) {
-	return file_Message_proto_rawDescGZIP(), []int{8, 0}
+	return file_Message_proto_rawDescGZIP(), []int{27, 0}
 }
 
 type Image struct {
@@ -108,18 +430,19 @@ type Image struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MessageUrl        *string `protobuf:"bytes,1,opt,name=messageUrl" json:"messageUrl,omitempty"`
-	MimeType          *string `protobuf:"bytes,2,opt,name=mimeType" json:"mimeType,omitempty"`
-	Text              []byte  `protobuf:"bytes,3,opt,name=text" json:"text,omitempty"`
-	FileHash          []byte  `protobuf:"bytes,4,opt,name=fileHash" json:"fileHash,omitempty"`
-	FileLength        *uint32 `protobuf:"varint,5,opt,name=fileLength" json:"fileLength,omitempty"`
-	Height            *uint32 `protobuf:"varint,6,opt,name=height" json:"height,omitempty"`
-	Width             *uint32 `protobuf:"varint,7,opt,name=width" json:"width,omitempty"`
-	MediaKey          []byte  `protobuf:"bytes,8,opt,name=mediaKey" json:"mediaKey,omitempty"`
-	EncFileHash       []byte  `protobuf:"bytes,9,opt,name=encFileHash" json:"encFileHash,omitempty"`
-	DirectPath        *string `protobuf:"bytes,11,opt,name=directPath" json:"directPath,omitempty"`
-	MediaKeyTimestamp *uint32 `protobuf:"varint,12,opt,name=mediaKeyTimestamp" json:"mediaKeyTimestamp,omitempty"`
-	Thumbnail         []byte  `protobuf:"bytes,16,opt,name=thumbnail" json:"thumbnail,omitempty"`
+	MessageUrl        *string      `protobuf:"bytes,1,opt,name=messageUrl" json:"messageUrl,omitempty"`
+	MimeType          *string      `protobuf:"bytes,2,opt,name=mimeType" json:"mimeType,omitempty"`
+	Text              []byte       `protobuf:"bytes,3,opt,name=text" json:"text,omitempty"`
+	FileHash          []byte       `protobuf:"bytes,4,opt,name=fileHash" json:"fileHash,omitempty"`
+	FileLength        *uint32      `protobuf:"varint,5,opt,name=fileLength" json:"fileLength,omitempty"`
+	Height            *uint32      `protobuf:"varint,6,opt,name=height" json:"height,omitempty"`
+	Width             *uint32      `protobuf:"varint,7,opt,name=width" json:"width,omitempty"`
+	MediaKey          []byte       `protobuf:"bytes,8,opt,name=mediaKey" json:"mediaKey,omitempty"`
+	EncFileHash       []byte       `protobuf:"bytes,9,opt,name=encFileHash" json:"encFileHash,omitempty"`
+	DirectPath        *string      `protobuf:"bytes,11,opt,name=directPath" json:"directPath,omitempty"`
+	MediaKeyTimestamp *uint32      `protobuf:"varint,12,opt,name=mediaKeyTimestamp" json:"mediaKeyTimestamp,omitempty"`
+	Thumbnail         []byte       `protobuf:"bytes,16,opt,name=thumbnail" json:"thumbnail,omitempty"`
+	ContextInfo       *ContextInfo `protobuf:"bytes,17,opt,name=contextInfo" json:"contextInfo,omitempty"`
 	//
 	//sidecar:
 	//https://github.com/sigalor/whatsapp-web-reveng/blob/master/README.md
@@ -135,6 +458,7 @@ type Image struct {
 	FirstScanLength   []uint32 `protobuf:"varint,22,rep,name=firstScanLength" json:"firstScanLength,omitempty"`
 	PartialMediaHash  []byte   `protobuf:"bytes,23,opt,name=partialMediaHash" json:"partialMediaHash,omitempty"`
 	PartialMediaEHash []byte   `protobuf:"bytes,24,opt,name=partialMediaEHash" json:"partialMediaEHash,omitempty"`
+	ViewOnce          *bool    `protobuf:"varint,25,opt,name=viewOnce" json:"viewOnce,omitempty"`
 }
 
 func (x *Image) Reset() {
@@ -253,6 +577,13 @@ func (x *Image) GetThumbnail() []byte {
 	return nil
 }
 
+func (x *Image) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
 func (x *Image) GetSidecar() []byte {
 	if x != nil {
 		return x.Sidecar
@@ -281,13 +612,21 @@ func (x *Image) GetPartialMediaEHash() []byte {
 	return nil
 }
 
+func (x *Image) GetViewOnce() bool {
+	if x != nil && x.ViewOnce != nil {
+		return *x.ViewOnce
+	}
+	return false
+}
+
 type Contact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Vcard *string `protobuf:"bytes,16,opt,name=vcard" json:"vcard,omitempty"`
+	Name        *string      `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Vcard       *string      `protobuf:"bytes,16,opt,name=vcard" json:"vcard,omitempty"`
+	ContextInfo *ContextInfo `protobuf:"bytes,17,opt,name=contextInfo" json:"contextInfo,omitempty"`
 }
 
 func (x *Contact) Reset() {
@@ -336,17 +675,34 @@ func (x *Contact) GetVcard() string {
 	return ""
 }
 
-type ContactArray struct {
+func (x *Contact) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+type Location struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title *string    `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
-	List  []*Contact `protobuf:"bytes,2,rep,name=list" json:"list,omitempty"`
-}
-
-func (x *ContactArray) Reset() {
-	*x = ContactArray{}
+	DegreesLatitude                   *float64     `protobuf:"fixed64,1,opt,name=degreesLatitude" json:"degreesLatitude,omitempty"`
+	DegreesLongitude                  *float64     `protobuf:"fixed64,2,opt,name=degreesLongitude" json:"degreesLongitude,omitempty"`
+	Name                              *string      `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Address                           *string      `protobuf:"bytes,4,opt,name=address" json:"address,omitempty"`
+	Url                               *string      `protobuf:"bytes,5,opt,name=url" json:"url,omitempty"`
+	IsLive                            *bool        `protobuf:"varint,6,opt,name=isLive" json:"isLive,omitempty"`
+	AccuracyInMeters                  *uint32      `protobuf:"varint,7,opt,name=accuracyInMeters" json:"accuracyInMeters,omitempty"`
+	SpeedInMps                        *float32     `protobuf:"fixed32,8,opt,name=speedInMps" json:"speedInMps,omitempty"`
+	DegreesClockwiseFromMagneticNorth *uint32      `protobuf:"varint,9,opt,name=degreesClockwiseFromMagneticNorth" json:"degreesClockwiseFromMagneticNorth,omitempty"`
+	Comment                           *string      `protobuf:"bytes,11,opt,name=comment" json:"comment,omitempty"`
+	JpegThumbnail                     []byte       `protobuf:"bytes,16,opt,name=jpegThumbnail" json:"jpegThumbnail,omitempty"`
+	ContextInfo                       *ContextInfo `protobuf:"bytes,17,opt,name=contextInfo" json:"contextInfo,omitempty"`
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Message_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -354,13 +710,13 @@ func (x *ContactArray) Reset() {
 	}
 }
 
-func (x *ContactArray) String() string {
+func (x *Location) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContactArray) ProtoMessage() {}
+func (*Location) ProtoMessage() {}
 
-func (x *ContactArray) ProtoReflect() protoreflect.Message {
+func (x *Location) ProtoReflect() protoreflect.Message {
 	mi := &file_Message_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -372,112 +728,363 @@ func (x *ContactArray) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContactArray.ProtoReflect.Descriptor instead.
-func (*ContactArray) Descriptor() ([]byte, []int) {
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
 	return file_Message_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ContactArray) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *Location) GetDegreesLatitude() float64 {
+	if x != nil && x.DegreesLatitude != nil {
+		return *x.DegreesLatitude
 	}
-	return ""
+	return 0
 }
 
-func (x *ContactArray) GetList() []*Contact {
-	if x != nil {
-		return x.List
+func (x *Location) GetDegreesLongitude() float64 {
+	if x != nil && x.DegreesLongitude != nil {
+		return *x.DegreesLongitude
 	}
-	return nil
+	return 0
 }
 
-//
-//[32] 6 string: (6721):
-//[0a] 1 string: (20): https://www.bing.com
-//[12] 2 string: (20): https://www.bing.com
-//[22] 4 string: (61): https://www.bing.com/?form=HPFBBK&ssd=20220814_0700&mkt=zh-HK
-//[2a] 5 string: (50): This camouflaged panther chameleon is one of a mul
-//[32] 6 string: (4): Info (49 6e 66 6f)
-//[50] 10 varint: 0 (0x0)
-//[82 01] 16 string: (6547): ff d8 ff e0 00 10 4a 46 49 46 00 01 01 00 00 01 00 01 00 00 ff db 00 43 00 06 04 05 06 05 04 06 ...
-//[e8 01] 29 varint: 0 (0x0)
-//
-//or :
-//
-//[32] 6 string: (6985):
-//[0a] 1 string: (20): https://www.bing.com
-//[12] 2 string: (20): https://www.bing.com
-//[22] 4 string: (61): https://www.bing.com/?form=HPFBBK&ssd=20220814_0700&mkt=zh-HK
-//[2a] 5 string: (50): This camouflaged panther chameleon is one of a mul
-//[32] 6 string: (4): Info (49 6e 66 6f)
-//[50] 10 varint: 0 (0x0)
-//[82 01] 16 string: (6547): ff d8 ff e0 00 10 4a 46 49 46 00 01 01 00 00 01 00 01 00 00 ff db 00 43 00 06 04 05 06 05 04 06 ...
-//
-//[9a 01] 19 string: (140): /v/t62.36144-24/35204578_832677781476215_8323076411855510632_n.enc?ccb=11-4&oh=01_AVxEiKlu4liXcBUBxQsW2tOUUdel5RHPvJZYq07AyjKIJA&oe=631F8D2A
-//[a2 01] 20 string: (32): ...RawBigImg.Sha256
-//[aa 01] 21 string: (32): (WTF) f3 1b 0c 6f 4e a7 eb f8 3f 5c c3 a0 91 8c 68 1a 30 9c 8f b8 f4 8b 35 d9 22 ab e5 de d9 15 56 e6
-//[b2 01] 22 string: (32): (WTF) f5 fc 37 7b 4d 08 82 b2 02 bc c1 aa b2 d2 2f de 42 64 06 cf 64 06 d8 7c f7 e4 89 ad c4 1e 22 51
-//[b8 01] 23 varint: 1660471608 (0x62f8c938)
-//[c0 01] 24 varint: 768 (0x300)
-//[c8 01] 25 varint: 1366 (0x556)
-//
-//[e8 01] 29 varint: 0 (0x0)
-//
-type Url struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Data         *string `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
-	Url          *string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
-	MediaName    *string `protobuf:"bytes,5,opt,name=mediaName" json:"mediaName,omitempty"`
-	MediaCaption *string `protobuf:"bytes,6,opt,name=mediaCaption" json:"mediaCaption,omitempty"`
-	// sns
-	TextColor       *uint32 `protobuf:"fixed32,7,opt,name=textColor" json:"textColor,omitempty"`             // ffffffff
-	BackgroundColor *uint32 `protobuf:"fixed32,8,opt,name=backgroundColor" json:"backgroundColor,omitempty"` // ffxxxxxx
-	FontStyle       *int32  `protobuf:"varint,9,opt,name=fontStyle" json:"fontStyle,omitempty"`
-	Int_10          *int32  `protobuf:"varint,10,opt,name=int_10,json=int10" json:"int_10,omitempty"`
-	ThumbImage      []byte  `protobuf:"bytes,16,opt,name=thumbImage" json:"thumbImage,omitempty"`
-	// optional
-	DirectPath     *string `protobuf:"bytes,19,opt,name=directPath" json:"directPath,omitempty"`
-	RawImageHash   []byte  `protobuf:"bytes,20,opt,name=rawImageHash" json:"rawImageHash,omitempty"` // Sha256 of raw big image
-	Wtf_32Bytes_21 []byte  `protobuf:"bytes,21,opt,name=wtf_32_bytes_21,json=wtf32Bytes21" json:"wtf_32_bytes_21,omitempty"`
-	Wtf_32Bytes_22 []byte  `protobuf:"bytes,22,opt,name=wtf_32_bytes_22,json=wtf32Bytes22" json:"wtf_32_bytes_22,omitempty"`
-	Timestamp      *int32  `protobuf:"varint,23,opt,name=timestamp" json:"timestamp,omitempty"`
-	ImgHeight      *int32  `protobuf:"varint,24,opt,name=imgHeight" json:"imgHeight,omitempty"`
-	ImgWidth       *int32  `protobuf:"varint,25,opt,name=imgWidth" json:"imgWidth,omitempty"`
+func (x *Location) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
 }
 
-func (x *Url) Reset() {
-	*x = Url{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_Message_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Location) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
 	}
+	return ""
 }
 
-func (x *Url) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Location) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
 }
 
-func (*Url) ProtoMessage() {}
+func (x *Location) GetIsLive() bool {
+	if x != nil && x.IsLive != nil {
+		return *x.IsLive
+	}
+	return false
+}
 
-func (x *Url) ProtoReflect() protoreflect.Message {
-	mi := &file_Message_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Location) GetAccuracyInMeters() uint32 {
+	if x != nil && x.AccuracyInMeters != nil {
+		return *x.AccuracyInMeters
 	}
-	return mi.MessageOf(x)
+	return 0
+}
+
+func (x *Location) GetSpeedInMps() float32 {
+	if x != nil && x.SpeedInMps != nil {
+		return *x.SpeedInMps
+	}
+	return 0
+}
+
+func (x *Location) GetDegreesClockwiseFromMagneticNorth() uint32 {
+	if x != nil && x.DegreesClockwiseFromMagneticNorth != nil {
+		return *x.DegreesClockwiseFromMagneticNorth
+	}
+	return 0
+}
+
+func (x *Location) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *Location) GetJpegThumbnail() []byte {
+	if x != nil {
+		return x.JpegThumbnail
+	}
+	return nil
+}
+
+func (x *Location) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+type LiveLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DegreesLatitude                   *float64     `protobuf:"fixed64,1,opt,name=degreesLatitude" json:"degreesLatitude,omitempty"`
+	DegreesLongitude                  *float64     `protobuf:"fixed64,2,opt,name=degreesLongitude" json:"degreesLongitude,omitempty"`
+	AccuracyInMeters                  *uint32      `protobuf:"varint,3,opt,name=accuracyInMeters" json:"accuracyInMeters,omitempty"`
+	SpeedInMps                        *float32     `protobuf:"fixed32,4,opt,name=speedInMps" json:"speedInMps,omitempty"`
+	DegreesClockwiseFromMagneticNorth *uint32      `protobuf:"varint,5,opt,name=degreesClockwiseFromMagneticNorth" json:"degreesClockwiseFromMagneticNorth,omitempty"`
+	Caption                           *string      `protobuf:"bytes,6,opt,name=caption" json:"caption,omitempty"`
+	SequenceNumber                    *int64       `protobuf:"varint,7,opt,name=sequenceNumber" json:"sequenceNumber,omitempty"` // increases with each update
+	TimeOffset                        *uint32      `protobuf:"varint,8,opt,name=timeOffset" json:"timeOffset,omitempty"`         // seconds since sharing started
+	JpegThumbnail                     []byte       `protobuf:"bytes,16,opt,name=jpegThumbnail" json:"jpegThumbnail,omitempty"`
+	ContextInfo                       *ContextInfo `protobuf:"bytes,17,opt,name=contextInfo" json:"contextInfo,omitempty"`
+}
+
+func (x *LiveLocation) Reset() {
+	*x = LiveLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiveLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveLocation) ProtoMessage() {}
+
+func (x *LiveLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveLocation.ProtoReflect.Descriptor instead.
+func (*LiveLocation) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LiveLocation) GetDegreesLatitude() float64 {
+	if x != nil && x.DegreesLatitude != nil {
+		return *x.DegreesLatitude
+	}
+	return 0
+}
+
+func (x *LiveLocation) GetDegreesLongitude() float64 {
+	if x != nil && x.DegreesLongitude != nil {
+		return *x.DegreesLongitude
+	}
+	return 0
+}
+
+func (x *LiveLocation) GetAccuracyInMeters() uint32 {
+	if x != nil && x.AccuracyInMeters != nil {
+		return *x.AccuracyInMeters
+	}
+	return 0
+}
+
+func (x *LiveLocation) GetSpeedInMps() float32 {
+	if x != nil && x.SpeedInMps != nil {
+		return *x.SpeedInMps
+	}
+	return 0
+}
+
+func (x *LiveLocation) GetDegreesClockwiseFromMagneticNorth() uint32 {
+	if x != nil && x.DegreesClockwiseFromMagneticNorth != nil {
+		return *x.DegreesClockwiseFromMagneticNorth
+	}
+	return 0
+}
+
+func (x *LiveLocation) GetCaption() string {
+	if x != nil && x.Caption != nil {
+		return *x.Caption
+	}
+	return ""
+}
+
+func (x *LiveLocation) GetSequenceNumber() int64 {
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *LiveLocation) GetTimeOffset() uint32 {
+	if x != nil && x.TimeOffset != nil {
+		return *x.TimeOffset
+	}
+	return 0
+}
+
+func (x *LiveLocation) GetJpegThumbnail() []byte {
+	if x != nil {
+		return x.JpegThumbnail
+	}
+	return nil
+}
+
+func (x *LiveLocation) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+type ContactArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       *string      `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
+	List        []*Contact   `protobuf:"bytes,2,rep,name=list" json:"list,omitempty"`
+	ContextInfo *ContextInfo `protobuf:"bytes,17,opt,name=contextInfo" json:"contextInfo,omitempty"`
+}
+
+func (x *ContactArray) Reset() {
+	*x = ContactArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContactArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactArray) ProtoMessage() {}
+
+func (x *ContactArray) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactArray.ProtoReflect.Descriptor instead.
+func (*ContactArray) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ContactArray) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *ContactArray) GetList() []*Contact {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ContactArray) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+// [32] 6 string: (6721):
+// [0a] 1 string: (20): https://www.bing.com
+// [12] 2 string: (20): https://www.bing.com
+// [22] 4 string: (61): https://www.bing.com/?form=HPFBBK&ssd=20220824_0700&mkt=zh-HK
+// [2a] 5 string: (50): This camouflaged panther chameleon is one of a mul
+// [32] 6 string: (4): Info (49 6e 66 6f)
+// [50] 10 varint: 0 (0x0)
+// [82 01] 16 string: (6547): ff d8 ff e0 00 10 4a 46 49 46 00 01 01 00 00 01 00 01 00 00 ff db 00 43 00 06 04 05 06 05 04 06 ...
+// [e8 01] 29 varint: 0 (0x0)
+//
+// or :
+//
+// [32] 6 string: (6985):
+// [0a] 1 string: (20): https://www.bing.com
+// [12] 2 string: (20): https://www.bing.com
+// [22] 4 string: (61): https://www.bing.com/?form=HPFBBK&ssd=20220814_0700&mkt=zh-HK
+// [2a] 5 string: (50): This camouflaged panther chameleon is one of a mul
+// [32] 6 string: (4): Info (49 6e 66 6f)
+// [50] 10 varint: 0 (0x0)
+// [82 01] 16 string: (6547): ff d8 ff e0 00 10 4a 46 49 46 00 01 01 00 00 01 00 01 00 00 ff db 00 43 00 06 04 05 06 05 04 06 ...
+//
+// [9a 01] 19 string: (140): /v/t62.36244-24/35204578_832677781476215_8323076411855510632_n.enc?ccb=11-4&oh=01_AVxEiKlu4liXcBUBxQsW2tOUUdel5RHPvJZYq07AyjKIJA&oe=631F8D2A
+// [a2 01] 20 string: (32): ...RawBigImg.Sha256
+// [aa 01] 21 string: (32): (WTF) f3 1b 0c 6f 4e a7 eb f8 3f 5c c3 a0 91 8c 68 1a 30 9c 8f b8 f4 8b 35 d9 22 ab e5 de d9 15 56 e6
+// [b2 01] 22 string: (32): (WTF) f5 fc 37 7b 4d 08 82 b2 02 bc c1 aa b2 d2 2f de 42 64 06 cf 64 06 d8 7c f7 e4 89 ad c4 1e 22 51
+// [b8 01] 23 varint: 1660471608 (0x62f8c938)
+// [c0 01] 24 varint: 768 (0x300)
+// [c8 01] 25 varint: 1366 (0x556)
+//
+// [e8 01] 29 varint: 0 (0x0)
+type Url struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data         *string `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Url          *string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
+	MediaName    *string `protobuf:"bytes,5,opt,name=mediaName" json:"mediaName,omitempty"`
+	MediaCaption *string `protobuf:"bytes,6,opt,name=mediaCaption" json:"mediaCaption,omitempty"`
+	// sns
+	TextColor       *uint32      `protobuf:"fixed32,7,opt,name=textColor" json:"textColor,omitempty"`             // ffffffff
+	BackgroundColor *uint32      `protobuf:"fixed32,8,opt,name=backgroundColor" json:"backgroundColor,omitempty"` // ffxxxxxx
+	FontStyle       *int32       `protobuf:"varint,9,opt,name=fontStyle" json:"fontStyle,omitempty"`
+	Int_10          *int32       `protobuf:"varint,10,opt,name=int_10,json=int10" json:"int_10,omitempty"`
+	ThumbImage      []byte       `protobuf:"bytes,16,opt,name=thumbImage" json:"thumbImage,omitempty"`
+	ContextInfo     *ContextInfo `protobuf:"bytes,17,opt,name=contextInfo" json:"contextInfo,omitempty"`
+	// optional
+	DirectPath     *string `protobuf:"bytes,19,opt,name=directPath" json:"directPath,omitempty"`
+	RawImageHash   []byte  `protobuf:"bytes,20,opt,name=rawImageHash" json:"rawImageHash,omitempty"` // Sha256 of raw big image
+	Wtf_32Bytes_21 []byte  `protobuf:"bytes,21,opt,name=wtf_32_bytes_21,json=wtf32Bytes21" json:"wtf_32_bytes_21,omitempty"`
+	Wtf_32Bytes_22 []byte  `protobuf:"bytes,22,opt,name=wtf_32_bytes_22,json=wtf32Bytes22" json:"wtf_32_bytes_22,omitempty"`
+	Timestamp      *int32  `protobuf:"varint,23,opt,name=timestamp" json:"timestamp,omitempty"`
+	ImgHeight      *int32  `protobuf:"varint,24,opt,name=imgHeight" json:"imgHeight,omitempty"`
+	ImgWidth       *int32  `protobuf:"varint,25,opt,name=imgWidth" json:"imgWidth,omitempty"`
+}
+
+func (x *Url) Reset() {
+	*x = Url{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Url) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Url) ProtoMessage() {}
+
+func (x *Url) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
 // Deprecated: Use Url.ProtoReflect.Descriptor instead.
 func (*Url) Descriptor() ([]byte, []int) {
-	return file_Message_proto_rawDescGZIP(), []int{3}
+	return file_Message_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Url) GetData() string {
@@ -543,6 +1150,13 @@ func (x *Url) GetThumbImage() []byte {
 	return nil
 }
 
+func (x *Url) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
 func (x *Url) GetDirectPath() string {
 	if x != nil && x.DirectPath != nil {
 		return *x.DirectPath
@@ -597,23 +1211,24 @@ type Document struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MediaUrl          *string `protobuf:"bytes,1,opt,name=mediaUrl" json:"mediaUrl,omitempty"`
-	MimeType          *string `protobuf:"bytes,2,opt,name=mimeType" json:"mimeType,omitempty"`
-	MediaName         *string `protobuf:"bytes,3,opt,name=mediaName" json:"mediaName,omitempty"`
-	MediaHash         []byte  `protobuf:"bytes,4,opt,name=mediaHash" json:"mediaHash,omitempty"`
-	MediaSize         *uint32 `protobuf:"varint,5,opt,name=mediaSize" json:"mediaSize,omitempty"`
-	Int_6             *int32  `protobuf:"varint,6,opt,name=int_6,json=int6" json:"int_6,omitempty"`
-	MediaKey          []byte  `protobuf:"bytes,7,opt,name=mediaKey" json:"mediaKey,omitempty"`
-	MediaCaption      *string `protobuf:"bytes,8,opt,name=mediaCaption" json:"mediaCaption,omitempty"`
-	MediaEncHash      []byte  `protobuf:"bytes,9,opt,name=mediaEncHash" json:"mediaEncHash,omitempty"`
-	DirectPath        *string `protobuf:"bytes,10,opt,name=directPath" json:"directPath,omitempty"`
-	MediaKeyTimestamp *uint32 `protobuf:"varint,11,opt,name=mediaKeyTimestamp" json:"mediaKeyTimestamp,omitempty"`
+	MediaUrl          *string      `protobuf:"bytes,1,opt,name=mediaUrl" json:"mediaUrl,omitempty"`
+	MimeType          *string      `protobuf:"bytes,2,opt,name=mimeType" json:"mimeType,omitempty"`
+	MediaName         *string      `protobuf:"bytes,3,opt,name=mediaName" json:"mediaName,omitempty"`
+	MediaHash         []byte       `protobuf:"bytes,4,opt,name=mediaHash" json:"mediaHash,omitempty"`
+	MediaSize         *uint32      `protobuf:"varint,5,opt,name=mediaSize" json:"mediaSize,omitempty"`
+	Int_6             *int32       `protobuf:"varint,6,opt,name=int_6,json=int6" json:"int_6,omitempty"`
+	MediaKey          []byte       `protobuf:"bytes,7,opt,name=mediaKey" json:"mediaKey,omitempty"`
+	MediaCaption      *string      `protobuf:"bytes,8,opt,name=mediaCaption" json:"mediaCaption,omitempty"`
+	MediaEncHash      []byte       `protobuf:"bytes,9,opt,name=mediaEncHash" json:"mediaEncHash,omitempty"`
+	DirectPath        *string      `protobuf:"bytes,10,opt,name=directPath" json:"directPath,omitempty"`
+	MediaKeyTimestamp *uint32      `protobuf:"varint,11,opt,name=mediaKeyTimestamp" json:"mediaKeyTimestamp,omitempty"`
+	ContextInfo       *ContextInfo `protobuf:"bytes,17,opt,name=contextInfo" json:"contextInfo,omitempty"`
 }
 
 func (x *Document) Reset() {
 	*x = Document{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Message_proto_msgTypes[4]
+		mi := &file_Message_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -626,7 +1241,7 @@ func (x *Document) String() string {
 func (*Document) ProtoMessage() {}
 
 func (x *Document) ProtoReflect() protoreflect.Message {
-	mi := &file_Message_proto_msgTypes[4]
+	mi := &file_Message_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -639,7 +1254,7 @@ func (x *Document) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Document.ProtoReflect.Descriptor instead.
 func (*Document) Descriptor() ([]byte, []int) {
-	return file_Message_proto_rawDescGZIP(), []int{4}
+	return file_Message_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Document) GetMediaUrl() string {
@@ -719,27 +1334,37 @@ func (x *Document) GetMediaKeyTimestamp() uint32 {
 	return 0
 }
 
+func (x *Document) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
 type Ptt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MessageUrl        *string `protobuf:"bytes,1,opt,name=messageUrl" json:"messageUrl,omitempty"`
-	MimeType          *string `protobuf:"bytes,2,opt,name=mimeType" json:"mimeType,omitempty"`
-	FileHash          []byte  `protobuf:"bytes,3,opt,name=fileHash" json:"fileHash,omitempty"`
-	FileLength        *uint32 `protobuf:"varint,4,opt,name=fileLength" json:"fileLength,omitempty"`
-	MediaDuration     *uint32 `protobuf:"varint,5,opt,name=mediaDuration" json:"mediaDuration,omitempty"`
-	Origin            *uint32 `protobuf:"varint,6,opt,name=origin" json:"origin,omitempty"` 
-	MediaKey          []byte  `protobuf:"bytes,7,opt,name=mediaKey" json:"mediaKey,omitempty"`
-	EncFileHash       []byte  `protobuf:"bytes,8,opt,name=encFileHash" json:"encFileHash,omitempty"`
-	DirectPath        *string `protobuf:"bytes,9,opt,name=directPath" json:"directPath,omitempty"`
-	MediaKeyTimestamp *uint32 `protobuf:"varint,10,opt,name=mediaKeyTimestamp" json:"mediaKeyTimestamp,omitempty"` //optional string                   = 18; // Mac ? not sure
+	MessageUrl        *string      `protobuf:"bytes,1,opt,name=messageUrl" json:"messageUrl,omitempty"`
+	MimeType          *string      `protobuf:"bytes,2,opt,name=mimeType" json:"mimeType,omitempty"`
+	FileHash          []byte       `protobuf:"bytes,3,opt,name=fileHash" json:"fileHash,omitempty"`
+	FileLength        *uint32      `protobuf:"varint,4,opt,name=fileLength" json:"fileLength,omitempty"`
+	MediaDuration     *uint32      `protobuf:"varint,5,opt,name=mediaDuration" json:"mediaDuration,omitempty"`
+	Origin            *uint32      `protobuf:"varint,6,opt,name=origin" json:"origin,omitempty"`
+	MediaKey          []byte       `protobuf:"bytes,7,opt,name=mediaKey" json:"mediaKey,omitempty"`
+	EncFileHash       []byte       `protobuf:"bytes,8,opt,name=encFileHash" json:"encFileHash,omitempty"`
+	DirectPath        *string      `protobuf:"bytes,9,opt,name=directPath" json:"directPath,omitempty"`
+	MediaKeyTimestamp *uint32      `protobuf:"varint,10,opt,name=mediaKeyTimestamp" json:"mediaKeyTimestamp,omitempty"`
+	ContextInfo       *ContextInfo `protobuf:"bytes,17,opt,name=contextInfo" json:"contextInfo,omitempty"`
+	Sidecar           []byte       `protobuf:"bytes,18,opt,name=sidecar" json:"sidecar,omitempty"` //message_streaming_sidecar sidecar
+	ViewOnce          *bool        `protobuf:"varint,21,opt,name=viewOnce" json:"viewOnce,omitempty"`
 }
 
 func (x *Ptt) Reset() {
 	*x = Ptt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Message_proto_msgTypes[5]
+		mi := &file_Message_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -752,7 +1377,7 @@ func (x *Ptt) String() string {
 func (*Ptt) ProtoMessage() {}
 
 func (x *Ptt) ProtoReflect() protoreflect.Message {
-	mi := &file_Message_proto_msgTypes[5]
+	mi := &file_Message_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -765,7 +1390,7 @@ func (x *Ptt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ptt.ProtoReflect.Descriptor instead.
 func (*Ptt) Descriptor() ([]byte, []int) {
-	return file_Message_proto_rawDescGZIP(), []int{5}
+	return file_Message_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Ptt) GetMessageUrl() string {
@@ -838,31 +1463,54 @@ func (x *Ptt) GetMediaKeyTimestamp() uint32 {
 	return 0
 }
 
+func (x *Ptt) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+func (x *Ptt) GetSidecar() []byte {
+	if x != nil {
+		return x.Sidecar
+	}
+	return nil
+}
+
+func (x *Ptt) GetViewOnce() bool {
+	if x != nil && x.ViewOnce != nil {
+		return *x.ViewOnce
+	}
+	return false
+}
+
 type Video struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MessageUrl        *string `protobuf:"bytes,1,opt,name=messageUrl" json:"messageUrl,omitempty"`
-	MimeType          *string `protobuf:"bytes,2,opt,name=mimeType" json:"mimeType,omitempty"`
-	FileHash          []byte  `protobuf:"bytes,3,opt,name=fileHash" json:"fileHash,omitempty"`
-	FileLength        *uint32 `protobuf:"varint,4,opt,name=fileLength" json:"fileLength,omitempty"`
-	MediaDuration     *uint32 `protobuf:"varint,5,opt,name=mediaDuration" json:"mediaDuration,omitempty"` // in seconds
-	MediaKey          []byte  `protobuf:"bytes,6,opt,name=mediaKey" json:"mediaKey,omitempty"`
-	Text              []byte  `protobuf:"bytes,7,opt,name=text" json:"text,omitempty"`
-	Height            *uint32 `protobuf:"varint,9,opt,name=height" json:"height,omitempty"`
-	Width             *uint32 `protobuf:"varint,10,opt,name=width" json:"width,omitempty"`
-	EncFileHash       []byte  `protobuf:"bytes,11,opt,name=encFileHash" json:"encFileHash,omitempty"`
-	DirectPath        *string `protobuf:"bytes,13,opt,name=directPath" json:"directPath,omitempty"`
-	MediaKeyTimestamp *uint32 `protobuf:"varint,14,opt,name=mediaKeyTimestamp" json:"mediaKeyTimestamp,omitempty"`
-	Thumbnail         []byte  `protobuf:"bytes,16,opt,name=thumbnail" json:"thumbnail,omitempty"`
-	Sidecar           []byte  `protobuf:"bytes,18,opt,name=sidecar" json:"sidecar,omitempty"` //message_streaming_sidecar sidecar
+	MessageUrl        *string      `protobuf:"bytes,1,opt,name=messageUrl" json:"messageUrl,omitempty"`
+	MimeType          *string      `protobuf:"bytes,2,opt,name=mimeType" json:"mimeType,omitempty"`
+	FileHash          []byte       `protobuf:"bytes,3,opt,name=fileHash" json:"fileHash,omitempty"`
+	FileLength        *uint32      `protobuf:"varint,4,opt,name=fileLength" json:"fileLength,omitempty"`
+	MediaDuration     *uint32      `protobuf:"varint,5,opt,name=mediaDuration" json:"mediaDuration,omitempty"` // in seconds
+	MediaKey          []byte       `protobuf:"bytes,6,opt,name=mediaKey" json:"mediaKey,omitempty"`
+	Text              []byte       `protobuf:"bytes,7,opt,name=text" json:"text,omitempty"`
+	Height            *uint32      `protobuf:"varint,9,opt,name=height" json:"height,omitempty"`
+	Width             *uint32      `protobuf:"varint,10,opt,name=width" json:"width,omitempty"`
+	EncFileHash       []byte       `protobuf:"bytes,11,opt,name=encFileHash" json:"encFileHash,omitempty"`
+	DirectPath        *string      `protobuf:"bytes,13,opt,name=directPath" json:"directPath,omitempty"`
+	MediaKeyTimestamp *uint32      `protobuf:"varint,14,opt,name=mediaKeyTimestamp" json:"mediaKeyTimestamp,omitempty"`
+	Thumbnail         []byte       `protobuf:"bytes,16,opt,name=thumbnail" json:"thumbnail,omitempty"`
+	ContextInfo       *ContextInfo `protobuf:"bytes,17,opt,name=contextInfo" json:"contextInfo,omitempty"`
+	Sidecar           []byte       `protobuf:"bytes,18,opt,name=sidecar" json:"sidecar,omitempty"` //message_streaming_sidecar sidecar
+	ViewOnce          *bool        `protobuf:"varint,20,opt,name=viewOnce" json:"viewOnce,omitempty"`
 }
 
 func (x *Video) Reset() {
 	*x = Video{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Message_proto_msgTypes[6]
+		mi := &file_Message_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -875,7 +1523,7 @@ func (x *Video) String() string {
 func (*Video) ProtoMessage() {}
 
 func (x *Video) ProtoReflect() protoreflect.Message {
-	mi := &file_Message_proto_msgTypes[6]
+	mi := &file_Message_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,7 +1536,7 @@ func (x *Video) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Video.ProtoReflect.Descriptor instead.
 func (*Video) Descriptor() ([]byte, []int) {
-	return file_Message_proto_rawDescGZIP(), []int{6}
+	return file_Message_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Video) GetMessageUrl() string {
@@ -982,6 +1630,13 @@ func (x *Video) GetThumbnail() []byte {
 	return nil
 }
 
+func (x *Video) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
 func (x *Video) GetSidecar() []byte {
 	if x != nil {
 		return x.Sidecar
@@ -989,27 +1644,35 @@ func (x *Video) GetSidecar() []byte {
 	return nil
 }
 
+func (x *Video) GetViewOnce() bool {
+	if x != nil && x.ViewOnce != nil {
+		return *x.ViewOnce
+	}
+	return false
+}
+
 type Sticker struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MessageUrl        *string `protobuf:"bytes,1,opt,name=messageUrl" json:"messageUrl,omitempty"`
-	FileHash          []byte  `protobuf:"bytes,2,opt,name=fileHash" json:"fileHash,omitempty"`
-	EncFileHash       []byte  `protobuf:"bytes,3,opt,name=encFileHash" json:"encFileHash,omitempty"`
-	MediaKey          []byte  `protobuf:"bytes,4,opt,name=mediaKey" json:"mediaKey,omitempty"`
-	MimeType          *string `protobuf:"bytes,5,opt,name=mimeType" json:"mimeType,omitempty"`
-	Width             *uint32 `protobuf:"varint,6,opt,name=width" json:"width,omitempty"`
-	Height            *uint32 `protobuf:"varint,7,opt,name=height" json:"height,omitempty"`
-	DirectPath        *string `protobuf:"bytes,8,opt,name=directPath" json:"directPath,omitempty"`
-	FileLength        *uint32 `protobuf:"varint,9,opt,name=fileLength" json:"fileLength,omitempty"`
-	MediaKeyTimestamp *uint32 `protobuf:"varint,10,opt,name=mediaKeyTimestamp" json:"mediaKeyTimestamp,omitempty"` //optional uint32 int_13    = 13;
+	MessageUrl        *string      `protobuf:"bytes,1,opt,name=messageUrl" json:"messageUrl,omitempty"`
+	FileHash          []byte       `protobuf:"bytes,2,opt,name=fileHash" json:"fileHash,omitempty"`
+	EncFileHash       []byte       `protobuf:"bytes,3,opt,name=encFileHash" json:"encFileHash,omitempty"`
+	MediaKey          []byte       `protobuf:"bytes,4,opt,name=mediaKey" json:"mediaKey,omitempty"`
+	MimeType          *string      `protobuf:"bytes,5,opt,name=mimeType" json:"mimeType,omitempty"`
+	Width             *uint32      `protobuf:"varint,6,opt,name=width" json:"width,omitempty"`
+	Height            *uint32      `protobuf:"varint,7,opt,name=height" json:"height,omitempty"`
+	DirectPath        *string      `protobuf:"bytes,8,opt,name=directPath" json:"directPath,omitempty"`
+	FileLength        *uint32      `protobuf:"varint,9,opt,name=fileLength" json:"fileLength,omitempty"`
+	MediaKeyTimestamp *uint32      `protobuf:"varint,10,opt,name=mediaKeyTimestamp" json:"mediaKeyTimestamp,omitempty"`
+	ContextInfo       *ContextInfo `protobuf:"bytes,17,opt,name=contextInfo" json:"contextInfo,omitempty"` //optional uint32 int_13    = 13;
 }
 
 func (x *Sticker) Reset() {
 	*x = Sticker{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Message_proto_msgTypes[7]
+		mi := &file_Message_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1022,7 +1685,7 @@ func (x *Sticker) String() string {
 func (*Sticker) ProtoMessage() {}
 
 func (x *Sticker) ProtoReflect() protoreflect.Message {
-	mi := &file_Message_proto_msgTypes[7]
+	mi := &file_Message_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1035,7 +1698,7 @@ func (x *Sticker) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sticker.ProtoReflect.Descriptor instead.
 func (*Sticker) Descriptor() ([]byte, []int) {
-	return file_Message_proto_rawDescGZIP(), []int{7}
+	return file_Message_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Sticker) GetMessageUrl() string {
@@ -1108,29 +1771,156 @@ func (x *Sticker) GetMediaKeyTimestamp() uint32 {
 	return 0
 }
 
-type Media struct {
+func (x *Sticker) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+// reply/mention info attached to a message
+type ContextInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	StanzaId      *string  `protobuf:"bytes,1,opt,name=stanzaId" json:"stanzaId,omitempty"`       // id of the quoted message
+	Participant   *string  `protobuf:"bytes,2,opt,name=participant" json:"participant,omitempty"` // sender of the quoted message
+	QuotedMessage *Message `protobuf:"bytes,3,opt,name=quotedMessage" json:"quotedMessage,omitempty"`
+	RemoteJid     *string  `protobuf:"bytes,4,opt,name=remoteJid" json:"remoteJid,omitempty"`
+	MentionedJid  []string `protobuf:"bytes,15,rep,name=mentionedJid" json:"mentionedJid,omitempty"`
+	// forwarded message, score +1 for each forward
+	ForwardingScore *uint32 `protobuf:"varint,21,opt,name=forwardingScore" json:"forwardingScore,omitempty"`
+	IsForwarded     *bool   `protobuf:"varint,22,opt,name=isForwarded" json:"isForwarded,omitempty"`
+	// disappearing messages
+	Expiration                *uint32 `protobuf:"varint,25,opt,name=expiration" json:"expiration,omitempty"` // seconds
+	EphemeralSettingTimestamp *int64  `protobuf:"varint,26,opt,name=ephemeralSettingTimestamp" json:"ephemeralSettingTimestamp,omitempty"`
+}
+
+func (x *ContextInfo) Reset() {
+	*x = ContextInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *Media) Reset() {
-	*x = Media{}
+func (x *ContextInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContextInfo) ProtoMessage() {}
+
+func (x *ContextInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContextInfo.ProtoReflect.Descriptor instead.
+func (*ContextInfo) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ContextInfo) GetStanzaId() string {
+	if x != nil && x.StanzaId != nil {
+		return *x.StanzaId
+	}
+	return ""
+}
+
+func (x *ContextInfo) GetParticipant() string {
+	if x != nil && x.Participant != nil {
+		return *x.Participant
+	}
+	return ""
+}
+
+func (x *ContextInfo) GetQuotedMessage() *Message {
+	if x != nil {
+		return x.QuotedMessage
+	}
+	return nil
+}
+
+func (x *ContextInfo) GetRemoteJid() string {
+	if x != nil && x.RemoteJid != nil {
+		return *x.RemoteJid
+	}
+	return ""
+}
+
+func (x *ContextInfo) GetMentionedJid() []string {
+	if x != nil {
+		return x.MentionedJid
+	}
+	return nil
+}
+
+func (x *ContextInfo) GetForwardingScore() uint32 {
+	if x != nil && x.ForwardingScore != nil {
+		return *x.ForwardingScore
+	}
+	return 0
+}
+
+func (x *ContextInfo) GetIsForwarded() bool {
+	if x != nil && x.IsForwarded != nil {
+		return *x.IsForwarded
+	}
+	return false
+}
+
+func (x *ContextInfo) GetExpiration() uint32 {
+	if x != nil && x.Expiration != nil {
+		return *x.Expiration
+	}
+	return 0
+}
+
+func (x *ContextInfo) GetEphemeralSettingTimestamp() int64 {
+	if x != nil && x.EphemeralSettingTimestamp != nil {
+		return *x.EphemeralSettingTimestamp
+	}
+	return 0
+}
+
+// identifies a message in a chat
+type MessageKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemoteJid   *string `protobuf:"bytes,1,opt,name=remoteJid" json:"remoteJid,omitempty"` // chat jid
+	FromMe      *bool   `protobuf:"varint,2,opt,name=fromMe" json:"fromMe,omitempty"`
+	Id          *string `protobuf:"bytes,3,opt,name=id" json:"id,omitempty"`
+	Participant *string `protobuf:"bytes,4,opt,name=participant" json:"participant,omitempty"` // sender in group
+}
+
+func (x *MessageKey) Reset() {
+	*x = MessageKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Message_proto_msgTypes[8]
+		mi := &file_Message_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Media) String() string {
+func (x *MessageKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Media) ProtoMessage() {}
+func (*MessageKey) ProtoMessage() {}
 
-func (x *Media) ProtoReflect() protoreflect.Message {
-	mi := &file_Message_proto_msgTypes[8]
+func (x *MessageKey) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1141,45 +1931,67 @@ func (x *Media) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Media.ProtoReflect.Descriptor instead.
-func (*Media) Descriptor() ([]byte, []int) {
-	return file_Message_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MessageKey.ProtoReflect.Descriptor instead.
+func (*MessageKey) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{11}
 }
 
-type Message struct {
+func (x *MessageKey) GetRemoteJid() string {
+	if x != nil && x.RemoteJid != nil {
+		return *x.RemoteJid
+	}
+	return ""
+}
+
+func (x *MessageKey) GetFromMe() bool {
+	if x != nil && x.FromMe != nil {
+		return *x.FromMe
+	}
+	return false
+}
+
+func (x *MessageKey) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *MessageKey) GetParticipant() string {
+	if x != nil && x.Participant != nil {
+		return *x.Participant
+	}
+	return ""
+}
+
+type Reaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Text         []byte         `protobuf:"bytes,1,opt,name=text" json:"text,omitempty"`
-	Grp          *Message_Group `protobuf:"bytes,2,opt,name=grp" json:"grp,omitempty"`
-	Image        *Image         `protobuf:"bytes,3,opt,name=image" json:"image,omitempty"`
-	Contact      *Contact       `protobuf:"bytes,4,opt,name=contact" json:"contact,omitempty"`
-	Url          *Url           `protobuf:"bytes,6,opt,name=url" json:"url,omitempty"`
-	Document     *Document      `protobuf:"bytes,7,opt,name=document" json:"document,omitempty"`
-	Ptt          *Ptt           `protobuf:"bytes,8,opt,name=ptt" json:"ptt,omitempty"`
-	Video        *Video         `protobuf:"bytes,9,opt,name=video" json:"video,omitempty"`
-	ContactArray *ContactArray  `protobuf:"bytes,13,opt,name=contactArray" json:"contactArray,omitempty"`
-	Sticker      *Sticker       `protobuf:"bytes,26,opt,name=sticker" json:"sticker,omitempty"`
+	Key               *MessageKey `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`   // the message reacted to
+	Text              *string     `protobuf:"bytes,2,opt,name=text" json:"text,omitempty"` // emoji, empty for removal
+	GroupingKey       *string     `protobuf:"bytes,3,opt,name=groupingKey" json:"groupingKey,omitempty"`
+	SenderTimestampMs *int64      `protobuf:"varint,4,opt,name=senderTimestampMs" json:"senderTimestampMs,omitempty"`
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
+func (x *Reaction) Reset() {
+	*x = Reaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Message_proto_msgTypes[9]
+		mi := &file_Message_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Message) String() string {
+func (x *Reaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message) ProtoMessage() {}
+func (*Reaction) ProtoMessage() {}
 
-func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_Message_proto_msgTypes[9]
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,107 +2002,202 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
-	return file_Message_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Message) GetText() []byte {
+func (x *Reaction) GetKey() *MessageKey {
 	if x != nil {
-		return x.Text
+		return x.Key
 	}
 	return nil
 }
 
-func (x *Message) GetGrp() *Message_Group {
-	if x != nil {
-		return x.Grp
+func (x *Reaction) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
 	}
-	return nil
+	return ""
 }
 
-func (x *Message) GetImage() *Image {
-	if x != nil {
-		return x.Image
+func (x *Reaction) GetGroupingKey() string {
+	if x != nil && x.GroupingKey != nil {
+		return *x.GroupingKey
 	}
-	return nil
+	return ""
 }
 
-func (x *Message) GetContact() *Contact {
-	if x != nil {
-		return x.Contact
+func (x *Reaction) GetSenderTimestampMs() int64 {
+	if x != nil && x.SenderTimestampMs != nil {
+		return *x.SenderTimestampMs
 	}
-	return nil
+	return 0
 }
 
-func (x *Message) GetUrl() *Url {
-	if x != nil {
-		return x.Url
+type ProtocolMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key                       *MessageKey           `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"` // the message revoked/edited
+	Type                      *ProtocolMessage_Type `protobuf:"varint,2,opt,name=type,enum=ProtocolMessage_Type" json:"type,omitempty"`
+	EphemeralExpiration       *uint32               `protobuf:"varint,4,opt,name=ephemeralExpiration" json:"ephemeralExpiration,omitempty"` // seconds, 0 for off
+	EphemeralSettingTimestamp *int64                `protobuf:"varint,5,opt,name=ephemeralSettingTimestamp" json:"ephemeralSettingTimestamp,omitempty"`
+	EditedMessage             *Message              `protobuf:"bytes,14,opt,name=editedMessage" json:"editedMessage,omitempty"`
+	TimestampMs               *int64                `protobuf:"varint,15,opt,name=timestampMs" json:"timestampMs,omitempty"`
+}
+
+func (x *ProtocolMessage) Reset() {
+	*x = ProtocolMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Message) GetDocument() *Document {
-	if x != nil {
-		return x.Document
+func (x *ProtocolMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolMessage) ProtoMessage() {}
+
+func (x *ProtocolMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Message) GetPtt() *Ptt {
+// Deprecated: Use ProtocolMessage.ProtoReflect.Descriptor instead.
+func (*ProtocolMessage) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProtocolMessage) GetKey() *MessageKey {
 	if x != nil {
-		return x.Ptt
+		return x.Key
 	}
 	return nil
 }
 
-func (x *Message) GetVideo() *Video {
-	if x != nil {
-		return x.Video
+func (x *ProtocolMessage) GetType() ProtocolMessage_Type {
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
-	return nil
+	return ProtocolMessage_REVOKE
 }
 
-func (x *Message) GetContactArray() *ContactArray {
+func (x *ProtocolMessage) GetEphemeralExpiration() uint32 {
+	if x != nil && x.EphemeralExpiration != nil {
+		return *x.EphemeralExpiration
+	}
+	return 0
+}
+
+func (x *ProtocolMessage) GetEphemeralSettingTimestamp() int64 {
+	if x != nil && x.EphemeralSettingTimestamp != nil {
+		return *x.EphemeralSettingTimestamp
+	}
+	return 0
+}
+
+func (x *ProtocolMessage) GetEditedMessage() *Message {
 	if x != nil {
-		return x.ContactArray
+		return x.EditedMessage
 	}
 	return nil
 }
 
-func (x *Message) GetSticker() *Sticker {
+func (x *ProtocolMessage) GetTimestampMs() int64 {
+	if x != nil && x.TimestampMs != nil {
+		return *x.TimestampMs
+	}
+	return 0
+}
+
+type MessageContextInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageSecret []byte `protobuf:"bytes,3,opt,name=messageSecret" json:"messageSecret,omitempty"` // 32 bytes, for encrypting poll votes
+}
+
+func (x *MessageContextInfo) Reset() {
+	*x = MessageContextInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageContextInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageContextInfo) ProtoMessage() {}
+
+func (x *MessageContextInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageContextInfo.ProtoReflect.Descriptor instead.
+func (*MessageContextInfo) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MessageContextInfo) GetMessageSecret() []byte {
 	if x != nil {
-		return x.Sticker
+		return x.MessageSecret
 	}
 	return nil
 }
 
-type Message_Group struct {
+type PollCreation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   *string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Skdm []byte  `protobuf:"bytes,2,opt,name=skdm" json:"skdm,omitempty"` // sender key distribution message
+	EncKey                 []byte                 `protobuf:"bytes,1,opt,name=encKey" json:"encKey,omitempty"` // the message secret, not sent
+	Name                   *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Options                []*PollCreation_Option `protobuf:"bytes,3,rep,name=options" json:"options,omitempty"`
+	SelectableOptionsCount *uint32                `protobuf:"varint,4,opt,name=selectableOptionsCount" json:"selectableOptionsCount,omitempty"` // 0 for unlimited
+	ContextInfo            *ContextInfo           `protobuf:"bytes,5,opt,name=contextInfo" json:"contextInfo,omitempty"`
 }
 
-func (x *Message_Group) Reset() {
-	*x = Message_Group{}
+func (x *PollCreation) Reset() {
+	*x = PollCreation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Message_proto_msgTypes[10]
+		mi := &file_Message_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Message_Group) String() string {
+func (x *PollCreation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message_Group) ProtoMessage() {}
+func (*PollCreation) ProtoMessage() {}
 
-func (x *Message_Group) ProtoReflect() protoreflect.Message {
-	mi := &file_Message_proto_msgTypes[10]
+func (x *PollCreation) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1301,43 +2208,1801 @@ func (x *Message_Group) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message_Group.ProtoReflect.Descriptor instead.
-func (*Message_Group) Descriptor() ([]byte, []int) {
-	return file_Message_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use PollCreation.ProtoReflect.Descriptor instead.
+func (*PollCreation) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Message_Group) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *PollCreation) GetEncKey() []byte {
+	if x != nil {
+		return x.EncKey
+	}
+	return nil
+}
+
+func (x *PollCreation) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *Message_Group) GetSkdm() []byte {
+func (x *PollCreation) GetOptions() []*PollCreation_Option {
 	if x != nil {
-		return x.Skdm
+		return x.Options
 	}
 	return nil
 }
 
-var File_Message_proto protoreflect.FileDescriptor
+func (x *PollCreation) GetSelectableOptionsCount() uint32 {
+	if x != nil && x.SelectableOptionsCount != nil {
+		return *x.SelectableOptionsCount
+	}
+	return 0
+}
 
-var file_Message_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x89, 0x04, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4c,
-	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69,
-	0x64, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x12,
+func (x *PollCreation) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+type PollEncValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EncPayload []byte `protobuf:"bytes,1,opt,name=encPayload" json:"encPayload,omitempty"` // aes-gcm encrypted PollVote
+	EncIv      []byte `protobuf:"bytes,2,opt,name=encIv" json:"encIv,omitempty"`
+}
+
+func (x *PollEncValue) Reset() {
+	*x = PollEncValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollEncValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollEncValue) ProtoMessage() {}
+
+func (x *PollEncValue) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollEncValue.ProtoReflect.Descriptor instead.
+func (*PollEncValue) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PollEncValue) GetEncPayload() []byte {
+	if x != nil {
+		return x.EncPayload
+	}
+	return nil
+}
+
+func (x *PollEncValue) GetEncIv() []byte {
+	if x != nil {
+		return x.EncIv
+	}
+	return nil
+}
+
+type PollUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PollCreationMessageKey *MessageKey   `protobuf:"bytes,1,opt,name=pollCreationMessageKey" json:"pollCreationMessageKey,omitempty"`
+	Vote                   *PollEncValue `protobuf:"bytes,2,opt,name=vote" json:"vote,omitempty"`
+	SenderTimestampMs      *int64        `protobuf:"varint,4,opt,name=senderTimestampMs" json:"senderTimestampMs,omitempty"`
+}
+
+func (x *PollUpdate) Reset() {
+	*x = PollUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollUpdate) ProtoMessage() {}
+
+func (x *PollUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollUpdate.ProtoReflect.Descriptor instead.
+func (*PollUpdate) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PollUpdate) GetPollCreationMessageKey() *MessageKey {
+	if x != nil {
+		return x.PollCreationMessageKey
+	}
+	return nil
+}
+
+func (x *PollUpdate) GetVote() *PollEncValue {
+	if x != nil {
+		return x.Vote
+	}
+	return nil
+}
+
+func (x *PollUpdate) GetSenderTimestampMs() int64 {
+	if x != nil && x.SenderTimestampMs != nil {
+		return *x.SenderTimestampMs
+	}
+	return 0
+}
+
+type PollVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SelectedOptions [][]byte `protobuf:"bytes,1,rep,name=selectedOptions" json:"selectedOptions,omitempty"` // sha256 of option names
+}
+
+func (x *PollVote) Reset() {
+	*x = PollVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollVote) ProtoMessage() {}
+
+func (x *PollVote) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollVote.ProtoReflect.Descriptor instead.
+func (*PollVote) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PollVote) GetSelectedOptions() [][]byte {
+	if x != nil {
+		return x.SelectedOptions
+	}
+	return nil
+}
+
+// business interactive messages
+type ButtonsMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text        *string                    `protobuf:"bytes,1,opt,name=text" json:"text,omitempty"` // header text
+	ContentText *string                    `protobuf:"bytes,6,opt,name=contentText" json:"contentText,omitempty"`
+	FooterText  *string                    `protobuf:"bytes,7,opt,name=footerText" json:"footerText,omitempty"`
+	ContextInfo *ContextInfo               `protobuf:"bytes,8,opt,name=contextInfo" json:"contextInfo,omitempty"`
+	Buttons     []*ButtonsMessage_Button   `protobuf:"bytes,9,rep,name=buttons" json:"buttons,omitempty"`
+	HeaderType  *ButtonsMessage_HeaderType `protobuf:"varint,10,opt,name=headerType,enum=ButtonsMessage_HeaderType" json:"headerType,omitempty"`
+}
+
+func (x *ButtonsMessage) Reset() {
+	*x = ButtonsMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ButtonsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ButtonsMessage) ProtoMessage() {}
+
+func (x *ButtonsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ButtonsMessage.ProtoReflect.Descriptor instead.
+func (*ButtonsMessage) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ButtonsMessage) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+func (x *ButtonsMessage) GetContentText() string {
+	if x != nil && x.ContentText != nil {
+		return *x.ContentText
+	}
+	return ""
+}
+
+func (x *ButtonsMessage) GetFooterText() string {
+	if x != nil && x.FooterText != nil {
+		return *x.FooterText
+	}
+	return ""
+}
+
+func (x *ButtonsMessage) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+func (x *ButtonsMessage) GetButtons() []*ButtonsMessage_Button {
+	if x != nil {
+		return x.Buttons
+	}
+	return nil
+}
+
+func (x *ButtonsMessage) GetHeaderType() ButtonsMessage_HeaderType {
+	if x != nil && x.HeaderType != nil {
+		return *x.HeaderType
+	}
+	return ButtonsMessage_UNKNOWN
+}
+
+type ButtonsResponseMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SelectedButtonId    *string                      `protobuf:"bytes,1,opt,name=selectedButtonId" json:"selectedButtonId,omitempty"`
+	SelectedDisplayText *string                      `protobuf:"bytes,2,opt,name=selectedDisplayText" json:"selectedDisplayText,omitempty"`
+	ContextInfo         *ContextInfo                 `protobuf:"bytes,3,opt,name=contextInfo" json:"contextInfo,omitempty"`
+	Type                *ButtonsResponseMessage_Type `protobuf:"varint,4,opt,name=type,enum=ButtonsResponseMessage_Type" json:"type,omitempty"`
+}
+
+func (x *ButtonsResponseMessage) Reset() {
+	*x = ButtonsResponseMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ButtonsResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ButtonsResponseMessage) ProtoMessage() {}
+
+func (x *ButtonsResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ButtonsResponseMessage.ProtoReflect.Descriptor instead.
+func (*ButtonsResponseMessage) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ButtonsResponseMessage) GetSelectedButtonId() string {
+	if x != nil && x.SelectedButtonId != nil {
+		return *x.SelectedButtonId
+	}
+	return ""
+}
+
+func (x *ButtonsResponseMessage) GetSelectedDisplayText() string {
+	if x != nil && x.SelectedDisplayText != nil {
+		return *x.SelectedDisplayText
+	}
+	return ""
+}
+
+func (x *ButtonsResponseMessage) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+func (x *ButtonsResponseMessage) GetType() ButtonsResponseMessage_Type {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ButtonsResponseMessage_UNKNOWN
+}
+
+type ListMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       *string                `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	ButtonText  *string                `protobuf:"bytes,3,opt,name=buttonText" json:"buttonText,omitempty"`
+	ListType    *ListMessage_ListType  `protobuf:"varint,4,opt,name=listType,enum=ListMessage_ListType" json:"listType,omitempty"`
+	Sections    []*ListMessage_Section `protobuf:"bytes,5,rep,name=sections" json:"sections,omitempty"`
+	FooterText  *string                `protobuf:"bytes,7,opt,name=footerText" json:"footerText,omitempty"`
+	ContextInfo *ContextInfo           `protobuf:"bytes,8,opt,name=contextInfo" json:"contextInfo,omitempty"`
+}
+
+func (x *ListMessage) Reset() {
+	*x = ListMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessage) ProtoMessage() {}
+
+func (x *ListMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessage.ProtoReflect.Descriptor instead.
+func (*ListMessage) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListMessage) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *ListMessage) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ListMessage) GetButtonText() string {
+	if x != nil && x.ButtonText != nil {
+		return *x.ButtonText
+	}
+	return ""
+}
+
+func (x *ListMessage) GetListType() ListMessage_ListType {
+	if x != nil && x.ListType != nil {
+		return *x.ListType
+	}
+	return ListMessage_UNKNOWN
+}
+
+func (x *ListMessage) GetSections() []*ListMessage_Section {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *ListMessage) GetFooterText() string {
+	if x != nil && x.FooterText != nil {
+		return *x.FooterText
+	}
+	return ""
+}
+
+func (x *ListMessage) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+type ListResponseMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title             *string                                `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
+	ListType          *ListMessage_ListType                  `protobuf:"varint,2,opt,name=listType,enum=ListMessage_ListType" json:"listType,omitempty"`
+	SingleSelectReply *ListResponseMessage_SingleSelectReply `protobuf:"bytes,3,opt,name=singleSelectReply" json:"singleSelectReply,omitempty"`
+	ContextInfo       *ContextInfo                           `protobuf:"bytes,4,opt,name=contextInfo" json:"contextInfo,omitempty"`
+	Description       *string                                `protobuf:"bytes,5,opt,name=description" json:"description,omitempty"`
+}
+
+func (x *ListResponseMessage) Reset() {
+	*x = ListResponseMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponseMessage) ProtoMessage() {}
+
+func (x *ListResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponseMessage.ProtoReflect.Descriptor instead.
+func (*ListResponseMessage) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListResponseMessage) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *ListResponseMessage) GetListType() ListMessage_ListType {
+	if x != nil && x.ListType != nil {
+		return *x.ListType
+	}
+	return ListMessage_UNKNOWN
+}
+
+func (x *ListResponseMessage) GetSingleSelectReply() *ListResponseMessage_SingleSelectReply {
+	if x != nil {
+		return x.SingleSelectReply
+	}
+	return nil
+}
+
+func (x *ListResponseMessage) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+func (x *ListResponseMessage) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type HydratedTemplateButton struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuickReplyButton *HydratedTemplateButton_QuickReplyButton `protobuf:"bytes,1,opt,name=quickReplyButton" json:"quickReplyButton,omitempty"`
+	UrlButton        *HydratedTemplateButton_UrlButton        `protobuf:"bytes,2,opt,name=urlButton" json:"urlButton,omitempty"`
+	CallButton       *HydratedTemplateButton_CallButton       `protobuf:"bytes,3,opt,name=callButton" json:"callButton,omitempty"`
+	Index            *uint32                                  `protobuf:"varint,4,opt,name=index" json:"index,omitempty"`
+}
+
+func (x *HydratedTemplateButton) Reset() {
+	*x = HydratedTemplateButton{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HydratedTemplateButton) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HydratedTemplateButton) ProtoMessage() {}
+
+func (x *HydratedTemplateButton) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HydratedTemplateButton.ProtoReflect.Descriptor instead.
+func (*HydratedTemplateButton) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *HydratedTemplateButton) GetQuickReplyButton() *HydratedTemplateButton_QuickReplyButton {
+	if x != nil {
+		return x.QuickReplyButton
+	}
+	return nil
+}
+
+func (x *HydratedTemplateButton) GetUrlButton() *HydratedTemplateButton_UrlButton {
+	if x != nil {
+		return x.UrlButton
+	}
+	return nil
+}
+
+func (x *HydratedTemplateButton) GetCallButton() *HydratedTemplateButton_CallButton {
+	if x != nil {
+		return x.CallButton
+	}
+	return nil
+}
+
+func (x *HydratedTemplateButton) GetIndex() uint32 {
+	if x != nil && x.Index != nil {
+		return *x.Index
+	}
+	return 0
+}
+
+type HydratedFourRowTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HydratedTitleText   *string                   `protobuf:"bytes,2,opt,name=hydratedTitleText" json:"hydratedTitleText,omitempty"`
+	HydratedContentText *string                   `protobuf:"bytes,6,opt,name=hydratedContentText" json:"hydratedContentText,omitempty"`
+	HydratedFooterText  *string                   `protobuf:"bytes,7,opt,name=hydratedFooterText" json:"hydratedFooterText,omitempty"`
+	HydratedButtons     []*HydratedTemplateButton `protobuf:"bytes,8,rep,name=hydratedButtons" json:"hydratedButtons,omitempty"`
+	TemplateId          *string                   `protobuf:"bytes,9,opt,name=templateId" json:"templateId,omitempty"`
+}
+
+func (x *HydratedFourRowTemplate) Reset() {
+	*x = HydratedFourRowTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HydratedFourRowTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HydratedFourRowTemplate) ProtoMessage() {}
+
+func (x *HydratedFourRowTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HydratedFourRowTemplate.ProtoReflect.Descriptor instead.
+func (*HydratedFourRowTemplate) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *HydratedFourRowTemplate) GetHydratedTitleText() string {
+	if x != nil && x.HydratedTitleText != nil {
+		return *x.HydratedTitleText
+	}
+	return ""
+}
+
+func (x *HydratedFourRowTemplate) GetHydratedContentText() string {
+	if x != nil && x.HydratedContentText != nil {
+		return *x.HydratedContentText
+	}
+	return ""
+}
+
+func (x *HydratedFourRowTemplate) GetHydratedFooterText() string {
+	if x != nil && x.HydratedFooterText != nil {
+		return *x.HydratedFooterText
+	}
+	return ""
+}
+
+func (x *HydratedFourRowTemplate) GetHydratedButtons() []*HydratedTemplateButton {
+	if x != nil {
+		return x.HydratedButtons
+	}
+	return nil
+}
+
+func (x *HydratedFourRowTemplate) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
+type TemplateMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContextInfo      *ContextInfo             `protobuf:"bytes,3,opt,name=contextInfo" json:"contextInfo,omitempty"`
+	HydratedTemplate *HydratedFourRowTemplate `protobuf:"bytes,4,opt,name=hydratedTemplate" json:"hydratedTemplate,omitempty"`
+}
+
+func (x *TemplateMessage) Reset() {
+	*x = TemplateMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateMessage) ProtoMessage() {}
+
+func (x *TemplateMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateMessage.ProtoReflect.Descriptor instead.
+func (*TemplateMessage) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TemplateMessage) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+func (x *TemplateMessage) GetHydratedTemplate() *HydratedFourRowTemplate {
+	if x != nil {
+		return x.HydratedTemplate
+	}
+	return nil
+}
+
+type TemplateButtonReplyMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SelectedId          *string      `protobuf:"bytes,1,opt,name=selectedId" json:"selectedId,omitempty"`
+	SelectedDisplayText *string      `protobuf:"bytes,2,opt,name=selectedDisplayText" json:"selectedDisplayText,omitempty"`
+	ContextInfo         *ContextInfo `protobuf:"bytes,3,opt,name=contextInfo" json:"contextInfo,omitempty"`
+	SelectedIndex       *uint32      `protobuf:"varint,4,opt,name=selectedIndex" json:"selectedIndex,omitempty"`
+}
+
+func (x *TemplateButtonReplyMessage) Reset() {
+	*x = TemplateButtonReplyMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateButtonReplyMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateButtonReplyMessage) ProtoMessage() {}
+
+func (x *TemplateButtonReplyMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateButtonReplyMessage.ProtoReflect.Descriptor instead.
+func (*TemplateButtonReplyMessage) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TemplateButtonReplyMessage) GetSelectedId() string {
+	if x != nil && x.SelectedId != nil {
+		return *x.SelectedId
+	}
+	return ""
+}
+
+func (x *TemplateButtonReplyMessage) GetSelectedDisplayText() string {
+	if x != nil && x.SelectedDisplayText != nil {
+		return *x.SelectedDisplayText
+	}
+	return ""
+}
+
+func (x *TemplateButtonReplyMessage) GetContextInfo() *ContextInfo {
+	if x != nil {
+		return x.ContextInfo
+	}
+	return nil
+}
+
+func (x *TemplateButtonReplyMessage) GetSelectedIndex() uint32 {
+	if x != nil && x.SelectedIndex != nil {
+		return *x.SelectedIndex
+	}
+	return 0
+}
+
+type Media struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Media) Reset() {
+	*x = Media{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Media) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media) ProtoMessage() {}
+
+func (x *Media) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media.ProtoReflect.Descriptor instead.
+func (*Media) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{27}
+}
+
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text                  []byte                      `protobuf:"bytes,1,opt,name=text" json:"text,omitempty"`
+	Grp                   *Message_Group              `protobuf:"bytes,2,opt,name=grp" json:"grp,omitempty"`
+	Image                 *Image                      `protobuf:"bytes,3,opt,name=image" json:"image,omitempty"`
+	Contact               *Contact                    `protobuf:"bytes,4,opt,name=contact" json:"contact,omitempty"`
+	Location              *Location                   `protobuf:"bytes,5,opt,name=location" json:"location,omitempty"`
+	Url                   *Url                        `protobuf:"bytes,6,opt,name=url" json:"url,omitempty"`
+	Document              *Document                   `protobuf:"bytes,7,opt,name=document" json:"document,omitempty"`
+	Ptt                   *Ptt                        `protobuf:"bytes,8,opt,name=ptt" json:"ptt,omitempty"`
+	Video                 *Video                      `protobuf:"bytes,9,opt,name=video" json:"video,omitempty"`
+	Protocol              *ProtocolMessage            `protobuf:"bytes,12,opt,name=protocol" json:"protocol,omitempty"`
+	ContactArray          *ContactArray               `protobuf:"bytes,13,opt,name=contactArray" json:"contactArray,omitempty"`
+	LiveLocation          *LiveLocation               `protobuf:"bytes,18,opt,name=liveLocation" json:"liveLocation,omitempty"`
+	Template              *TemplateMessage            `protobuf:"bytes,25,opt,name=template" json:"template,omitempty"`
+	Sticker               *Sticker                    `protobuf:"bytes,26,opt,name=sticker" json:"sticker,omitempty"`
+	TemplateButtonReply   *TemplateButtonReplyMessage `protobuf:"bytes,29,opt,name=templateButtonReply" json:"templateButtonReply,omitempty"`
+	DeviceSentMessage     *DeviceSentMessage          `protobuf:"bytes,31,opt,name=deviceSentMessage" json:"deviceSentMessage,omitempty"`
+	MessageContextInfo    *MessageContextInfo         `protobuf:"bytes,35,opt,name=messageContextInfo" json:"messageContextInfo,omitempty"`
+	List                  *ListMessage                `protobuf:"bytes,36,opt,name=list" json:"list,omitempty"`
+	ListResponse          *ListResponseMessage        `protobuf:"bytes,39,opt,name=listResponse" json:"listResponse,omitempty"`
+	ViewOnceMessage       *FutureProofMessage         `protobuf:"bytes,37,opt,name=viewOnceMessage" json:"viewOnceMessage,omitempty"`
+	EphemeralMessage      *FutureProofMessage         `protobuf:"bytes,40,opt,name=ephemeralMessage" json:"ephemeralMessage,omitempty"`
+	Buttons               *ButtonsMessage             `protobuf:"bytes,42,opt,name=buttons" json:"buttons,omitempty"`
+	ButtonsResponse       *ButtonsResponseMessage     `protobuf:"bytes,43,opt,name=buttonsResponse" json:"buttonsResponse,omitempty"`
+	Reaction              *Reaction                   `protobuf:"bytes,46,opt,name=reaction" json:"reaction,omitempty"`
+	PollCreation          *PollCreation               `protobuf:"bytes,49,opt,name=pollCreation" json:"pollCreation,omitempty"`
+	PollUpdate            *PollUpdate                 `protobuf:"bytes,50,opt,name=pollUpdate" json:"pollUpdate,omitempty"`
+	ViewOnceMessageV2     *FutureProofMessage         `protobuf:"bytes,55,opt,name=viewOnceMessageV2" json:"viewOnceMessageV2,omitempty"`
+	PollCreationMessageV2 *PollCreation               `protobuf:"bytes,60,opt,name=pollCreationMessageV2" json:"pollCreationMessageV2,omitempty"`
+	PollCreationMessageV3 *PollCreation               `protobuf:"bytes,64,opt,name=pollCreationMessageV3" json:"pollCreationMessageV3,omitempty"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Message) GetText() []byte {
+	if x != nil {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *Message) GetGrp() *Message_Group {
+	if x != nil {
+		return x.Grp
+	}
+	return nil
+}
+
+func (x *Message) GetImage() *Image {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *Message) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *Message) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Message) GetUrl() *Url {
+	if x != nil {
+		return x.Url
+	}
+	return nil
+}
+
+func (x *Message) GetDocument() *Document {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *Message) GetPtt() *Ptt {
+	if x != nil {
+		return x.Ptt
+	}
+	return nil
+}
+
+func (x *Message) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *Message) GetProtocol() *ProtocolMessage {
+	if x != nil {
+		return x.Protocol
+	}
+	return nil
+}
+
+func (x *Message) GetContactArray() *ContactArray {
+	if x != nil {
+		return x.ContactArray
+	}
+	return nil
+}
+
+func (x *Message) GetLiveLocation() *LiveLocation {
+	if x != nil {
+		return x.LiveLocation
+	}
+	return nil
+}
+
+func (x *Message) GetTemplate() *TemplateMessage {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *Message) GetSticker() *Sticker {
+	if x != nil {
+		return x.Sticker
+	}
+	return nil
+}
+
+func (x *Message) GetTemplateButtonReply() *TemplateButtonReplyMessage {
+	if x != nil {
+		return x.TemplateButtonReply
+	}
+	return nil
+}
+
+func (x *Message) GetDeviceSentMessage() *DeviceSentMessage {
+	if x != nil {
+		return x.DeviceSentMessage
+	}
+	return nil
+}
+
+func (x *Message) GetMessageContextInfo() *MessageContextInfo {
+	if x != nil {
+		return x.MessageContextInfo
+	}
+	return nil
+}
+
+func (x *Message) GetList() *ListMessage {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *Message) GetListResponse() *ListResponseMessage {
+	if x != nil {
+		return x.ListResponse
+	}
+	return nil
+}
+
+func (x *Message) GetViewOnceMessage() *FutureProofMessage {
+	if x != nil {
+		return x.ViewOnceMessage
+	}
+	return nil
+}
+
+func (x *Message) GetEphemeralMessage() *FutureProofMessage {
+	if x != nil {
+		return x.EphemeralMessage
+	}
+	return nil
+}
+
+func (x *Message) GetButtons() *ButtonsMessage {
+	if x != nil {
+		return x.Buttons
+	}
+	return nil
+}
+
+func (x *Message) GetButtonsResponse() *ButtonsResponseMessage {
+	if x != nil {
+		return x.ButtonsResponse
+	}
+	return nil
+}
+
+func (x *Message) GetReaction() *Reaction {
+	if x != nil {
+		return x.Reaction
+	}
+	return nil
+}
+
+func (x *Message) GetPollCreation() *PollCreation {
+	if x != nil {
+		return x.PollCreation
+	}
+	return nil
+}
+
+func (x *Message) GetPollUpdate() *PollUpdate {
+	if x != nil {
+		return x.PollUpdate
+	}
+	return nil
+}
+
+func (x *Message) GetViewOnceMessageV2() *FutureProofMessage {
+	if x != nil {
+		return x.ViewOnceMessageV2
+	}
+	return nil
+}
+
+func (x *Message) GetPollCreationMessageV2() *PollCreation {
+	if x != nil {
+		return x.PollCreationMessageV2
+	}
+	return nil
+}
+
+func (x *Message) GetPollCreationMessageV3() *PollCreation {
+	if x != nil {
+		return x.PollCreationMessageV3
+	}
+	return nil
+}
+
+// wrapper of view-once media and disappearing messages
+type FutureProofMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message" json:"message,omitempty"`
+}
+
+func (x *FutureProofMessage) Reset() {
+	*x = FutureProofMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FutureProofMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FutureProofMessage) ProtoMessage() {}
+
+func (x *FutureProofMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FutureProofMessage.ProtoReflect.Descriptor instead.
+func (*FutureProofMessage) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FutureProofMessage) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// sent to own companion devices, so they show the outgoing message
+type DeviceSentMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DestinationJid *string  `protobuf:"bytes,1,opt,name=destinationJid" json:"destinationJid,omitempty"`
+	Message        *Message `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	Phash          *string  `protobuf:"bytes,3,opt,name=phash" json:"phash,omitempty"`
+}
+
+func (x *DeviceSentMessage) Reset() {
+	*x = DeviceSentMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceSentMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceSentMessage) ProtoMessage() {}
+
+func (x *DeviceSentMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceSentMessage.ProtoReflect.Descriptor instead.
+func (*DeviceSentMessage) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeviceSentMessage) GetDestinationJid() string {
+	if x != nil && x.DestinationJid != nil {
+		return *x.DestinationJid
+	}
+	return ""
+}
+
+func (x *DeviceSentMessage) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *DeviceSentMessage) GetPhash() string {
+	if x != nil && x.Phash != nil {
+		return *x.Phash
+	}
+	return ""
+}
+
+type PollCreation_Option struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OptionName *string `protobuf:"bytes,1,opt,name=optionName" json:"optionName,omitempty"`
+}
+
+func (x *PollCreation_Option) Reset() {
+	*x = PollCreation_Option{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollCreation_Option) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollCreation_Option) ProtoMessage() {}
+
+func (x *PollCreation_Option) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollCreation_Option.ProtoReflect.Descriptor instead.
+func (*PollCreation_Option) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *PollCreation_Option) GetOptionName() string {
+	if x != nil && x.OptionName != nil {
+		return *x.OptionName
+	}
+	return ""
+}
+
+type ButtonsMessage_Button struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ButtonId   *string                           `protobuf:"bytes,1,opt,name=buttonId" json:"buttonId,omitempty"`
+	ButtonText *ButtonsMessage_Button_ButtonText `protobuf:"bytes,2,opt,name=buttonText" json:"buttonText,omitempty"`
+	Type       *ButtonsMessage_Button_Type       `protobuf:"varint,3,opt,name=type,enum=ButtonsMessage_Button_Type" json:"type,omitempty"`
+}
+
+func (x *ButtonsMessage_Button) Reset() {
+	*x = ButtonsMessage_Button{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ButtonsMessage_Button) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ButtonsMessage_Button) ProtoMessage() {}
+
+func (x *ButtonsMessage_Button) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ButtonsMessage_Button.ProtoReflect.Descriptor instead.
+func (*ButtonsMessage_Button) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *ButtonsMessage_Button) GetButtonId() string {
+	if x != nil && x.ButtonId != nil {
+		return *x.ButtonId
+	}
+	return ""
+}
+
+func (x *ButtonsMessage_Button) GetButtonText() *ButtonsMessage_Button_ButtonText {
+	if x != nil {
+		return x.ButtonText
+	}
+	return nil
+}
+
+func (x *ButtonsMessage_Button) GetType() ButtonsMessage_Button_Type {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ButtonsMessage_Button_UNKNOWN
+}
+
+type ButtonsMessage_Button_ButtonText struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisplayText *string `protobuf:"bytes,1,opt,name=displayText" json:"displayText,omitempty"`
+}
+
+func (x *ButtonsMessage_Button_ButtonText) Reset() {
+	*x = ButtonsMessage_Button_ButtonText{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ButtonsMessage_Button_ButtonText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ButtonsMessage_Button_ButtonText) ProtoMessage() {}
+
+func (x *ButtonsMessage_Button_ButtonText) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ButtonsMessage_Button_ButtonText.ProtoReflect.Descriptor instead.
+func (*ButtonsMessage_Button_ButtonText) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{19, 0, 0}
+}
+
+func (x *ButtonsMessage_Button_ButtonText) GetDisplayText() string {
+	if x != nil && x.DisplayText != nil {
+		return *x.DisplayText
+	}
+	return ""
+}
+
+type ListMessage_Row struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       *string `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
+	Description *string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	RowId       *string `protobuf:"bytes,3,opt,name=rowId" json:"rowId,omitempty"`
+}
+
+func (x *ListMessage_Row) Reset() {
+	*x = ListMessage_Row{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMessage_Row) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessage_Row) ProtoMessage() {}
+
+func (x *ListMessage_Row) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessage_Row.ProtoReflect.Descriptor instead.
+func (*ListMessage_Row) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *ListMessage_Row) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *ListMessage_Row) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ListMessage_Row) GetRowId() string {
+	if x != nil && x.RowId != nil {
+		return *x.RowId
+	}
+	return ""
+}
+
+type ListMessage_Section struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title *string            `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
+	Rows  []*ListMessage_Row `protobuf:"bytes,2,rep,name=rows" json:"rows,omitempty"`
+}
+
+func (x *ListMessage_Section) Reset() {
+	*x = ListMessage_Section{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMessage_Section) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessage_Section) ProtoMessage() {}
+
+func (x *ListMessage_Section) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessage_Section.ProtoReflect.Descriptor instead.
+func (*ListMessage_Section) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{21, 1}
+}
+
+func (x *ListMessage_Section) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *ListMessage_Section) GetRows() []*ListMessage_Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type ListResponseMessage_SingleSelectReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SelectedRowId *string `protobuf:"bytes,1,opt,name=selectedRowId" json:"selectedRowId,omitempty"`
+}
+
+func (x *ListResponseMessage_SingleSelectReply) Reset() {
+	*x = ListResponseMessage_SingleSelectReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResponseMessage_SingleSelectReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponseMessage_SingleSelectReply) ProtoMessage() {}
+
+func (x *ListResponseMessage_SingleSelectReply) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponseMessage_SingleSelectReply.ProtoReflect.Descriptor instead.
+func (*ListResponseMessage_SingleSelectReply) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *ListResponseMessage_SingleSelectReply) GetSelectedRowId() string {
+	if x != nil && x.SelectedRowId != nil {
+		return *x.SelectedRowId
+	}
+	return ""
+}
+
+type HydratedTemplateButton_QuickReplyButton struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisplayText *string `protobuf:"bytes,1,opt,name=displayText" json:"displayText,omitempty"`
+	Id          *string `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+}
+
+func (x *HydratedTemplateButton_QuickReplyButton) Reset() {
+	*x = HydratedTemplateButton_QuickReplyButton{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HydratedTemplateButton_QuickReplyButton) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HydratedTemplateButton_QuickReplyButton) ProtoMessage() {}
+
+func (x *HydratedTemplateButton_QuickReplyButton) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HydratedTemplateButton_QuickReplyButton.ProtoReflect.Descriptor instead.
+func (*HydratedTemplateButton_QuickReplyButton) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *HydratedTemplateButton_QuickReplyButton) GetDisplayText() string {
+	if x != nil && x.DisplayText != nil {
+		return *x.DisplayText
+	}
+	return ""
+}
+
+func (x *HydratedTemplateButton_QuickReplyButton) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+type HydratedTemplateButton_UrlButton struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisplayText *string `protobuf:"bytes,1,opt,name=displayText" json:"displayText,omitempty"`
+	Url         *string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
+}
+
+func (x *HydratedTemplateButton_UrlButton) Reset() {
+	*x = HydratedTemplateButton_UrlButton{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HydratedTemplateButton_UrlButton) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HydratedTemplateButton_UrlButton) ProtoMessage() {}
+
+func (x *HydratedTemplateButton_UrlButton) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HydratedTemplateButton_UrlButton.ProtoReflect.Descriptor instead.
+func (*HydratedTemplateButton_UrlButton) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{23, 1}
+}
+
+func (x *HydratedTemplateButton_UrlButton) GetDisplayText() string {
+	if x != nil && x.DisplayText != nil {
+		return *x.DisplayText
+	}
+	return ""
+}
+
+func (x *HydratedTemplateButton_UrlButton) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+type HydratedTemplateButton_CallButton struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisplayText *string `protobuf:"bytes,1,opt,name=displayText" json:"displayText,omitempty"`
+	PhoneNumber *string `protobuf:"bytes,2,opt,name=phoneNumber" json:"phoneNumber,omitempty"`
+}
+
+func (x *HydratedTemplateButton_CallButton) Reset() {
+	*x = HydratedTemplateButton_CallButton{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HydratedTemplateButton_CallButton) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HydratedTemplateButton_CallButton) ProtoMessage() {}
+
+func (x *HydratedTemplateButton_CallButton) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HydratedTemplateButton_CallButton.ProtoReflect.Descriptor instead.
+func (*HydratedTemplateButton_CallButton) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{23, 2}
+}
+
+func (x *HydratedTemplateButton_CallButton) GetDisplayText() string {
+	if x != nil && x.DisplayText != nil {
+		return *x.DisplayText
+	}
+	return ""
+}
+
+func (x *HydratedTemplateButton_CallButton) GetPhoneNumber() string {
+	if x != nil && x.PhoneNumber != nil {
+		return *x.PhoneNumber
+	}
+	return ""
+}
+
+type Message_Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   *string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Skdm []byte  `protobuf:"bytes,2,opt,name=skdm" json:"skdm,omitempty"` // sender key distribution message
+}
+
+func (x *Message_Group) Reset() {
+	*x = Message_Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Message_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message_Group) ProtoMessage() {}
+
+func (x *Message_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_Message_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message_Group.ProtoReflect.Descriptor instead.
+func (*Message_Group) Descriptor() ([]byte, []int) {
+	return file_Message_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *Message_Group) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *Message_Group) GetSkdm() []byte {
+	if x != nil {
+		return x.Skdm
+	}
+	return nil
+}
+
+var File_Message_proto protoreflect.FileDescriptor
+
+var file_Message_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xd5, 0x04, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x12,
 	0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x09,
 	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73,
 	0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18,
@@ -1346,7 +4011,10 @@ var file_Message_proto_rawDesc = []byte{
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x65,
 	0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
 	0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a,
+	0x28, 0x0c, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a,
 	0x07, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
 	0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74,
 	0x53, 0x63, 0x61, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0d,
@@ -1356,89 +4024,165 @@ var file_Message_proto_rawDesc = []byte{
 	0x74, 0x69, 0x61, 0x6c, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2c, 0x0a,
 	0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x45, 0x48, 0x61,
 	0x73, 0x68, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61,
-	0x6c, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x45, 0x48, 0x61, 0x73, 0x68, 0x22, 0x33, 0x0a, 0x07, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x63,
-	0x61, 0x72, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x63, 0x61, 0x72, 0x64,
-	0x22, 0x42, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x04,
-	0x6c, 0x69, 0x73, 0x74, 0x22, 0xf4, 0x03, 0x0a, 0x03, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x61, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6c, 0x6f,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x07, 0x52, 0x09, 0x74, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x07, 0x52, 0x0f, 0x62, 0x61, 0x63,
-	0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09,
-	0x66, 0x6f, 0x6e, 0x74, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x09, 0x66, 0x6f, 0x6e, 0x74, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x6e,
-	0x74, 0x5f, 0x31, 0x30, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x31,
-	0x30, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x61, 0x77, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73,
-	0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x72, 0x61, 0x77, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x0f, 0x77, 0x74, 0x66, 0x5f, 0x33, 0x32, 0x5f,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x32, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
-	0x77, 0x74, 0x66, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x32, 0x31, 0x12, 0x25, 0x0a, 0x0f,
-	0x77, 0x74, 0x66, 0x5f, 0x33, 0x32, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x32, 0x32, 0x18,
-	0x16, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x77, 0x74, 0x66, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x32, 0x32, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x18,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x6d, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x67, 0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x19, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x69, 0x6d, 0x67, 0x57, 0x69, 0x64, 0x74, 0x68, 0x22, 0xe3, 0x02, 0x0a, 0x08,
-	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09,
-	0x6d, 0x65, 0x64, 0x69, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x69, 0x6e,
-	0x74, 0x5f, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x69, 0x6e, 0x74, 0x36, 0x12,
-	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x6d,
-	0x65, 0x64, 0x69, 0x61, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x45, 0x6e, 0x63, 0x48, 0x61, 0x73, 0x68, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x45, 0x6e, 0x63, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50,
-	0x61, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
-	0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x22, 0xc7, 0x02, 0x0a, 0x03, 0x50, 0x74, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73,
-	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74,
-	0x68, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x65,
-	0x6e, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0b, 0x65, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a,
-	0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x6c, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x45, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x76,
+	0x69, 0x65, 0x77, 0x4f, 0x6e, 0x63, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76,
+	0x69, 0x65, 0x77, 0x4f, 0x6e, 0x63, 0x65, 0x22, 0x63, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x63, 0x61, 0x72, 0x64, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x63, 0x61, 0x72, 0x64, 0x12, 0x2e, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xc2, 0x03, 0x0a,
+	0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x67,
+	0x72, 0x65, 0x65, 0x73, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x4c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x4c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x64,
+	0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x69, 0x73, 0x4c, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x69, 0x73, 0x4c, 0x69, 0x76, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x75, 0x72,
+	0x61, 0x63, 0x79, 0x49, 0x6e, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x10, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x49, 0x6e, 0x4d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x65, 0x64, 0x49, 0x6e, 0x4d, 0x70,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x65, 0x64, 0x49, 0x6e,
+	0x4d, 0x70, 0x73, 0x12, 0x4c, 0x0a, 0x21, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x43, 0x6c,
+	0x6f, 0x63, 0x6b, 0x77, 0x69, 0x73, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x61, 0x67, 0x6e, 0x65,
+	0x74, 0x69, 0x63, 0x4e, 0x6f, 0x72, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x21,
+	0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x77, 0x69, 0x73, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x4e, 0x6f, 0x72, 0x74,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a,
+	0x70, 0x65, 0x67, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x6a, 0x70, 0x65, 0x67, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
+	0x6c, 0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0xb6, 0x03, 0x0a, 0x0c, 0x4c, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x4c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x67,
+	0x72, 0x65, 0x65, 0x73, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x10,
+	0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x4c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x75,
+	0x72, 0x61, 0x63, 0x79, 0x49, 0x6e, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x49, 0x6e, 0x4d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x70, 0x65, 0x65, 0x64, 0x49, 0x6e, 0x4d,
+	0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x65, 0x64, 0x49,
+	0x6e, 0x4d, 0x70, 0x73, 0x12, 0x4c, 0x0a, 0x21, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x77, 0x69, 0x73, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x61, 0x67, 0x6e,
+	0x65, 0x74, 0x69, 0x63, 0x4e, 0x6f, 0x72, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x21, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x77, 0x69, 0x73,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x4e, 0x6f, 0x72,
+	0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x70, 0x65, 0x67, 0x54, 0x68, 0x75, 0x6d,
+	0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6a, 0x70, 0x65,
+	0x67, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x72, 0x0a, 0x0c, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x1c, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa4,
+	0x04, 0x0a, 0x03, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x07, 0x52, 0x09, 0x74, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x0f,
+	0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x07, 0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x6e, 0x74, 0x53, 0x74,
+	0x79, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x6f, 0x6e, 0x74, 0x53,
+	0x74, 0x79, 0x6c, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x6e, 0x74, 0x5f, 0x31, 0x30, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x31, 0x30, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
+	0x68, 0x75, 0x6d, 0x62, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x72,
+	0x61, 0x77, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x72, 0x61, 0x77, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x25, 0x0a, 0x0f, 0x77, 0x74, 0x66, 0x5f, 0x33, 0x32, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f,
+	0x32, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x77, 0x74, 0x66, 0x33, 0x32, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x32, 0x31, 0x12, 0x25, 0x0a, 0x0f, 0x77, 0x74, 0x66, 0x5f, 0x33, 0x32,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x32, 0x32, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0c, 0x77, 0x74, 0x66, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x32, 0x32, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x6d, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x69, 0x6d, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x67,
+	0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6d, 0x67,
+	0x57, 0x69, 0x64, 0x74, 0x68, 0x22, 0x93, 0x03, 0x0a, 0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x5f, 0x36, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x69, 0x6e, 0x74, 0x36, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x61,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x45, 0x6e, 0x63, 0x48, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x45, 0x6e, 0x63, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2c, 0x0a,
 	0x11, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b,
-	0x65, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xab, 0x03, 0x0a, 0x05,
+	0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b,
+	0x65, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xad, 0x03, 0x0a, 0x03,
+	0x50, 0x74, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
+	0x69, 0x6c, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x46,
+	0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x4b, 0x65, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x6e, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x6e, 0x63, 0x65, 0x22, 0xf7, 0x03, 0x0a, 0x05,
 	0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70,
@@ -1464,62 +4208,427 @@ var file_Message_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
 	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x10,
 	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12,
+	0x2e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
 	0x18, 0x0a, 0x07, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x22, 0xbb, 0x02, 0x0a, 0x07, 0x53, 0x74,
-	0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x12,
-	0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77,
-	0x69, 0x64, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74,
-	0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6c,
-	0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66,
-	0x69, 0x6c, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x65, 0x64,
-	0x69, 0x61, 0x4b, 0x65, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x94, 0x01, 0x0a, 0x05, 0x4d, 0x65, 0x64, 0x69,
-	0x61, 0x22, 0x8a, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e,
-	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x10,
-	0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x6b, 0x64, 0x6d, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x72, 0x6c, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08,
-	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0x07, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x74,
-	0x74, 0x10, 0x08, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x10, 0x09, 0x12, 0x11,
-	0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x41, 0x72, 0x72, 0x61, 0x79, 0x10,
-	0x0d, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x10, 0x1a, 0x22, 0xfa,
-	0x02, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x20,
-	0x0a, 0x03, 0x67, 0x72, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x03, 0x67, 0x72, 0x70,
-	0x12, 0x1c, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x06, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x22,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x08, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x63, 0x74, 0x12, 0x16, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x04, 0x2e, 0x55, 0x72, 0x6c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x08, 0x64, 0x6f,
-	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x16, 0x0a, 0x03, 0x70, 0x74, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04,
-	0x2e, 0x50, 0x74, 0x74, 0x52, 0x03, 0x70, 0x74, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f,
-	0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x31, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x63, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x0c, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x63, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x22, 0x0a, 0x07, 0x73, 0x74,
-	0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53, 0x74,
-	0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x07, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x1a, 0x2b,
-	0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x64, 0x6d, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x6b, 0x64, 0x6d, 0x42, 0x06, 0x5a, 0x04, 0x2e,
-	0x3b, 0x70, 0x62,
+	0x52, 0x07, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x69, 0x65,
+	0x77, 0x4f, 0x6e, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x69, 0x65,
+	0x77, 0x4f, 0x6e, 0x63, 0x65, 0x22, 0xeb, 0x02, 0x0a, 0x07, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x72,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a,
+	0x0b, 0x65, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4b, 0x65, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0xe7, 0x02, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x6e, 0x7a, 0x61, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x6e, 0x7a, 0x61, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4a, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4a, 0x69, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x4a, 0x69, 0x64, 0x18,
+	0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x64,
+	0x4a, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3c, 0x0a, 0x19, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x19, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x74, 0x0a,
+	0x0a, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4a, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4a, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x6f,
+	0x6d, 0x4d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x6d, 0x4d,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x4b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69,
+	0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x4d, 0x73, 0x22, 0xda, 0x02, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65,
+	0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x30, 0x0a, 0x13, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
+	0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x19, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61,
+	0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x2e, 0x0a, 0x0d, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x0d, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x4d, 0x73, 0x22, 0x3b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x52,
+	0x45, 0x56, 0x4f, 0x4b, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x50, 0x48, 0x45, 0x4d,
+	0x45, 0x52, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x10,
+	0x0a, 0x0c, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x45, 0x44, 0x49, 0x54, 0x10, 0x0e,
+	0x22, 0x3a, 0x0a, 0x12, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xfc, 0x01, 0x0a,
+	0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x6e, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x65,
+	0x6e, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x50, 0x6f, 0x6c,
+	0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x1a, 0x28, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x0c, 0x50,
+	0x6f, 0x6c, 0x6c, 0x45, 0x6e, 0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x6e, 0x63, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x65, 0x6e, 0x63, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6e, 0x63, 0x49, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x65, 0x6e, 0x63, 0x49,
+	0x76, 0x22, 0xa2, 0x01, 0x0a, 0x0a, 0x50, 0x6f, 0x6c, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x43, 0x0a, 0x16, 0x70, 0x6f, 0x6c, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x16, 0x70,
+	0x6f, 0x6c, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x45, 0x6e, 0x63, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x22, 0x34, 0x0a, 0x08, 0x50, 0x6f, 0x6c, 0x6c, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa2, 0x04, 0x0a,
+	0x0e, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x6f, 0x74, 0x65, 0x72, 0x54,
+	0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x6f, 0x74, 0x65,
+	0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x07, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x52, 0x07,
+	0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x42, 0x75,
+	0x74, 0x74, 0x6f, 0x6e, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x1a, 0xeb, 0x01, 0x0a, 0x06, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0a, 0x62, 0x75,
+	0x74, 0x74, 0x6f, 0x6e, 0x54, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x54, 0x65, 0x78,
+	0x74, 0x52, 0x0a, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2f, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x42, 0x75,
+	0x74, 0x74, 0x6f, 0x6e, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x75, 0x74,
+	0x74, 0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x2e,
+	0x0a, 0x0a, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x54, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x22, 0x21,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10,
+	0x01, 0x22, 0x2e, 0x0a, 0x0a, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10,
+	0x02, 0x22, 0xff, 0x01, 0x0a, 0x16, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x10,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x42, 0x75, 0x74, 0x74, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x25, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x54, 0x45, 0x58,
+	0x54, 0x10, 0x01, 0x22, 0xe2, 0x03, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
+	0x75, 0x74, 0x74, 0x6f, 0x6e, 0x54, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x54, 0x65, 0x78, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x6c,
+	0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30,
+	0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x6f, 0x74, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x6f, 0x74, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x1a, 0x53, 0x0a, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x77, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x6f, 0x77, 0x49, 0x64, 0x1a, 0x45, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x2a, 0x0a, 0x08,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x5f,
+	0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0x01, 0x22, 0xc1, 0x02, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x54, 0x0a, 0x11, 0x73, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x11, 0x73, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x2e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x39, 0x0a, 0x11, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x52, 0x6f, 0x77, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x49, 0x64, 0x22, 0xe2, 0x03, 0x0a,
+	0x16, 0x48, 0x79, 0x64, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x10, 0x71, 0x75, 0x69, 0x63, 0x6b,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x48, 0x79, 0x64, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x69, 0x63, 0x6b,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x52, 0x10, 0x71, 0x75, 0x69,
+	0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x12, 0x3f, 0x0a,
+	0x09, 0x75, 0x72, 0x6c, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x48, 0x79, 0x64, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x2e, 0x55, 0x72, 0x6c, 0x42, 0x75, 0x74,
+	0x74, 0x6f, 0x6e, 0x52, 0x09, 0x75, 0x72, 0x6c, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x12, 0x42,
+	0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x48, 0x79, 0x64, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x2e, 0x43, 0x61, 0x6c, 0x6c,
+	0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x42, 0x75, 0x74, 0x74,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x1a, 0x44, 0x0a, 0x10, 0x51, 0x75, 0x69, 0x63,
+	0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x1a, 0x3f,
+	0x0a, 0x09, 0x55, 0x72, 0x6c, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x1a,
+	0x50, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x6c, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x8c, 0x02, 0x0a, 0x17, 0x48, 0x79, 0x64, 0x72, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6f,
+	0x75, 0x72, 0x52, 0x6f, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a,
+	0x11, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x68,
+	0x79, 0x64, 0x72, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65,
+	0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2e, 0x0a,
+	0x12, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6f, 0x6f, 0x74, 0x65, 0x72, 0x54,
+	0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x68, 0x79, 0x64, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x46, 0x6f, 0x6f, 0x74, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x41, 0x0a,
+	0x0f, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x65, 0x64, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x48, 0x79, 0x64, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x52,
+	0x0f, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x65, 0x64, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x22, 0x87, 0x01, 0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x44, 0x0a, 0x10, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x48, 0x79, 0x64, 0x72, 0x61, 0x74, 0x65, 0x64, 0x46, 0x6f, 0x75, 0x72, 0x52, 0x6f, 0x77,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x10, 0x68, 0x79, 0x64, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x1a, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2e, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0xd6, 0x02, 0x0a, 0x05, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x22, 0xcc, 0x02, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53,
+	0x6b, 0x64, 0x6d, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x10, 0x03,
+	0x12, 0x0b, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x10, 0x04, 0x12, 0x0c, 0x0a,
+	0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x55,
+	0x72, 0x6c, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x10, 0x07, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x74, 0x74, 0x10, 0x08, 0x12, 0x09, 0x0a, 0x05, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x10, 0x09, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x10, 0x0c, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f,
+	0x41, 0x72, 0x72, 0x61, 0x79, 0x10, 0x0d, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x69, 0x76, 0x65, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x12, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x10, 0x19, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x72, 0x10, 0x1a, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x5f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x10, 0x1d, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x10, 0x24, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x10, 0x27, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73,
+	0x10, 0x2a, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73, 0x5f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x10, 0x2b, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x6f, 0x6c, 0x6c,
+	0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x31, 0x12, 0x0f, 0x0a, 0x0b, 0x50,
+	0x6f, 0x6c, 0x6c, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x10, 0x32, 0x12, 0x0c, 0x0a, 0x08,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x2e, 0x22, 0xa4, 0x0b, 0x0a, 0x07, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x03, 0x67, 0x72,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x03, 0x67, 0x72, 0x70, 0x12, 0x1c, 0x0a, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x25,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x04, 0x2e, 0x55, 0x72, 0x6c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x25, 0x0a,
+	0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x03, 0x70, 0x74, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x04, 0x2e, 0x50, 0x74, 0x74, 0x52, 0x03, 0x70, 0x74, 0x74, 0x12, 0x1c, 0x0a, 0x05,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x31, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x31, 0x0a, 0x0c, 0x6c,
+	0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x6c, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x07,
+	0x73, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x07, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x12, 0x4d, 0x0a, 0x13, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x74, 0x74,
+	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x13, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x40, 0x0a, 0x11, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x11,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x43, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x12, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x24,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x6e, 0x63, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x46, 0x75,
+	0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x0f, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x3f, 0x0a, 0x10, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x46, 0x75,
+	0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x10, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73, 0x18, 0x2a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a,
+	0x0f, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x0f, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x2e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x0c, 0x70, 0x6f, 0x6c, 0x6c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x50, 0x6f, 0x6c, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70, 0x6f,
+	0x6c, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x6f,
+	0x6c, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x70, 0x6f, 0x6c,
+	0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x11, 0x76, 0x69, 0x65, 0x77, 0x4f,
+	0x6e, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x56, 0x32, 0x18, 0x37, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x11, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x6e, 0x63,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x56, 0x32, 0x12, 0x43, 0x0a, 0x15, 0x70, 0x6f,
+	0x6c, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x56, 0x32, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x6f, 0x6c, 0x6c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x70, 0x6f, 0x6c, 0x6c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x56, 0x32, 0x12,
+	0x43, 0x0a, 0x15, 0x70, 0x6f, 0x6c, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x56, 0x33, 0x18, 0x40, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x70,
+	0x6f, 0x6c, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x56, 0x33, 0x1a, 0x2b, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6b, 0x64, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x6b, 0x64,
+	0x6d, 0x22, 0x38, 0x0a, 0x12, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x75, 0x0a, 0x11, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61,
+	0x73, 0x68, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62,
 }
 
 var (
@@ -1534,38 +4643,134 @@ func file_Message_proto_rawDescGZIP() []byte {
 	return file_Message_proto_rawDescData
 }
 
-var file_Message_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_Message_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_Message_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_Message_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_Message_proto_goTypes = []interface{}{
-	(Media_Type)(0),       // 0: Media.Type
-	(*Image)(nil),         // 1: Image
-	(*Contact)(nil),       // 2: Contact
-	(*ContactArray)(nil),  // 3: ContactArray
-	(*Url)(nil),           // 4: Url
-	(*Document)(nil),      // 5: Document
-	(*Ptt)(nil),           // 6: Ptt
-	(*Video)(nil),         // 7: Video
-	(*Sticker)(nil),       // 8: Sticker
-	(*Media)(nil),         // 9: Media
-	(*Message)(nil),       // 10: Message
-	(*Message_Group)(nil), // 11: Message.Group
+	(ProtocolMessage_Type)(0),                       // 0: ProtocolMessage.Type
+	(ButtonsMessage_HeaderType)(0),                  // 1: ButtonsMessage.HeaderType
+	(ButtonsMessage_Button_Type)(0),                 // 2: ButtonsMessage.Button.Type
+	(ButtonsResponseMessage_Type)(0),                // 3: ButtonsResponseMessage.Type
+	(ListMessage_ListType)(0),                       // 4: ListMessage.ListType
+	(Media_Type)(0),                                 // 5: Media.Type
+	(*Image)(nil),                                   // 6: Image
+	(*Contact)(nil),                                 // 7: Contact
+	(*Location)(nil),                                // 8: Location
+	(*LiveLocation)(nil),                            // 9: LiveLocation
+	(*ContactArray)(nil),                            // 10: ContactArray
+	(*Url)(nil),                                     // 11: Url
+	(*Document)(nil),                                // 12: Document
+	(*Ptt)(nil),                                     // 13: Ptt
+	(*Video)(nil),                                   // 14: Video
+	(*Sticker)(nil),                                 // 15: Sticker
+	(*ContextInfo)(nil),                             // 16: ContextInfo
+	(*MessageKey)(nil),                              // 17: MessageKey
+	(*Reaction)(nil),                                // 18: Reaction
+	(*ProtocolMessage)(nil),                         // 19: ProtocolMessage
+	(*MessageContextInfo)(nil),                      // 20: MessageContextInfo
+	(*PollCreation)(nil),                            // 21: PollCreation
+	(*PollEncValue)(nil),                            // 22: PollEncValue
+	(*PollUpdate)(nil),                              // 23: PollUpdate
+	(*PollVote)(nil),                                // 24: PollVote
+	(*ButtonsMessage)(nil),                          // 25: ButtonsMessage
+	(*ButtonsResponseMessage)(nil),                  // 26: ButtonsResponseMessage
+	(*ListMessage)(nil),                             // 27: ListMessage
+	(*ListResponseMessage)(nil),                     // 28: ListResponseMessage
+	(*HydratedTemplateButton)(nil),                  // 29: HydratedTemplateButton
+	(*HydratedFourRowTemplate)(nil),                 // 30: HydratedFourRowTemplate
+	(*TemplateMessage)(nil),                         // 31: TemplateMessage
+	(*TemplateButtonReplyMessage)(nil),              // 32: TemplateButtonReplyMessage
+	(*Media)(nil),                                   // 33: Media
+	(*Message)(nil),                                 // 34: Message
+	(*FutureProofMessage)(nil),                      // 35: FutureProofMessage
+	(*DeviceSentMessage)(nil),                       // 36: DeviceSentMessage
+	(*PollCreation_Option)(nil),                     // 37: PollCreation.Option
+	(*ButtonsMessage_Button)(nil),                   // 38: ButtonsMessage.Button
+	(*ButtonsMessage_Button_ButtonText)(nil),        // 39: ButtonsMessage.Button.ButtonText
+	(*ListMessage_Row)(nil),                         // 40: ListMessage.Row
+	(*ListMessage_Section)(nil),                     // 41: ListMessage.Section
+	(*ListResponseMessage_SingleSelectReply)(nil),   // 42: ListResponseMessage.SingleSelectReply
+	(*HydratedTemplateButton_QuickReplyButton)(nil), // 43: HydratedTemplateButton.QuickReplyButton
+	(*HydratedTemplateButton_UrlButton)(nil),        // 44: HydratedTemplateButton.UrlButton
+	(*HydratedTemplateButton_CallButton)(nil),       // 45: HydratedTemplateButton.CallButton
+	(*Message_Group)(nil),                           // 46: Message.Group
 }
 var file_Message_proto_depIdxs = []int32{
-	2,  // 0: ContactArray.list:type_name -> Contact
-	11, // 1: Message.grp:type_name -> Message.Group
-	1,  // 2: Message.image:type_name -> Image
-	2,  // 3: Message.contact:type_name -> Contact
-	4,  // 4: Message.url:type_name -> Url
-	5,  // 5: Message.document:type_name -> Document
-	6,  // 6: Message.ptt:type_name -> Ptt
-	7,  // 7: Message.video:type_name -> Video
-	3,  // 8: Message.contactArray:type_name -> ContactArray
-	8,  // 9: Message.sticker:type_name -> Sticker
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	16, // 0: Image.contextInfo:type_name -> ContextInfo
+	16, // 1: Contact.contextInfo:type_name -> ContextInfo
+	16, // 2: Location.contextInfo:type_name -> ContextInfo
+	16, // 3: LiveLocation.contextInfo:type_name -> ContextInfo
+	7,  // 4: ContactArray.list:type_name -> Contact
+	16, // 5: ContactArray.contextInfo:type_name -> ContextInfo
+	16, // 6: Url.contextInfo:type_name -> ContextInfo
+	16, // 7: Document.contextInfo:type_name -> ContextInfo
+	16, // 8: Ptt.contextInfo:type_name -> ContextInfo
+	16, // 9: Video.contextInfo:type_name -> ContextInfo
+	16, // 10: Sticker.contextInfo:type_name -> ContextInfo
+	34, // 11: ContextInfo.quotedMessage:type_name -> Message
+	17, // 12: Reaction.key:type_name -> MessageKey
+	17, // 13: ProtocolMessage.key:type_name -> MessageKey
+	0,  // 14: ProtocolMessage.type:type_name -> ProtocolMessage.Type
+	34, // 15: ProtocolMessage.editedMessage:type_name -> Message
+	37, // 16: PollCreation.options:type_name -> PollCreation.Option
+	16, // 17: PollCreation.contextInfo:type_name -> ContextInfo
+	17, // 18: PollUpdate.pollCreationMessageKey:type_name -> MessageKey
+	22, // 19: PollUpdate.vote:type_name -> PollEncValue
+	16, // 20: ButtonsMessage.contextInfo:type_name -> ContextInfo
+	38, // 21: ButtonsMessage.buttons:type_name -> ButtonsMessage.Button
+	1,  // 22: ButtonsMessage.headerType:type_name -> ButtonsMessage.HeaderType
+	16, // 23: ButtonsResponseMessage.contextInfo:type_name -> ContextInfo
+	3,  // 24: ButtonsResponseMessage.type:type_name -> ButtonsResponseMessage.Type
+	4,  // 25: ListMessage.listType:type_name -> ListMessage.ListType
+	41, // 26: ListMessage.sections:type_name -> ListMessage.Section
+	16, // 27: ListMessage.contextInfo:type_name -> ContextInfo
+	4,  // 28: ListResponseMessage.listType:type_name -> ListMessage.ListType
+	42, // 29: ListResponseMessage.singleSelectReply:type_name -> ListResponseMessage.SingleSelectReply
+	16, // 30: ListResponseMessage.contextInfo:type_name -> ContextInfo
+	43, // 31: HydratedTemplateButton.quickReplyButton:type_name -> HydratedTemplateButton.QuickReplyButton
+	44, // 32: HydratedTemplateButton.urlButton:type_name -> HydratedTemplateButton.UrlButton
+	45, // 33: HydratedTemplateButton.callButton:type_name -> HydratedTemplateButton.CallButton
+	29, // 34: HydratedFourRowTemplate.hydratedButtons:type_name -> HydratedTemplateButton
+	16, // 35: TemplateMessage.contextInfo:type_name -> ContextInfo
+	30, // 36: TemplateMessage.hydratedTemplate:type_name -> HydratedFourRowTemplate
+	16, // 37: TemplateButtonReplyMessage.contextInfo:type_name -> ContextInfo
+	46, // 38: Message.grp:type_name -> Message.Group
+	6,  // 39: Message.image:type_name -> Image
+	7,  // 40: Message.contact:type_name -> Contact
+	8,  // 41: Message.location:type_name -> Location
+	11, // 42: Message.url:type_name -> Url
+	12, // 43: Message.document:type_name -> Document
+	13, // 44: Message.ptt:type_name -> Ptt
+	14, // 45: Message.video:type_name -> Video
+	19, // 46: Message.protocol:type_name -> ProtocolMessage
+	10, // 47: Message.contactArray:type_name -> ContactArray
+	9,  // 48: Message.liveLocation:type_name -> LiveLocation
+	31, // 49: Message.template:type_name -> TemplateMessage
+	15, // 50: Message.sticker:type_name -> Sticker
+	32, // 51: Message.templateButtonReply:type_name -> TemplateButtonReplyMessage
+	36, // 52: Message.deviceSentMessage:type_name -> DeviceSentMessage
+	20, // 53: Message.messageContextInfo:type_name -> MessageContextInfo
+	27, // 54: Message.list:type_name -> ListMessage
+	28, // 55: Message.listResponse:type_name -> ListResponseMessage
+	35, // 56: Message.viewOnceMessage:type_name -> FutureProofMessage
+	35, // 57: Message.ephemeralMessage:type_name -> FutureProofMessage
+	25, // 58: Message.buttons:type_name -> ButtonsMessage
+	26, // 59: Message.buttonsResponse:type_name -> ButtonsResponseMessage
+	18, // 60: Message.reaction:type_name -> Reaction
+	21, // 61: Message.pollCreation:type_name -> PollCreation
+	23, // 62: Message.pollUpdate:type_name -> PollUpdate
+	35, // 63: Message.viewOnceMessageV2:type_name -> FutureProofMessage
+	21, // 64: Message.pollCreationMessageV2:type_name -> PollCreation
+	21, // 65: Message.pollCreationMessageV3:type_name -> PollCreation
+	34, // 66: FutureProofMessage.message:type_name -> Message
+	34, // 67: DeviceSentMessage.message:type_name -> Message
+	39, // 68: ButtonsMessage.Button.buttonText:type_name -> ButtonsMessage.Button.ButtonText
+	2,  // 69: ButtonsMessage.Button.type:type_name -> ButtonsMessage.Button.Type
+	40, // 70: ListMessage.Section.rows:type_name -> ListMessage.Row
+	71, // [71:71] is the sub-list for method output_type
+	71, // [71:71] is the sub-list for method input_type
+	71, // [71:71] is the sub-list for extension type_name
+	71, // [71:71] is the sub-list for extension extendee
+	0,  // [0:71] is the sub-list for field type_name
 }
 
 func init() { file_Message_proto_init() }
@@ -1586,8 +4791,92 @@ func file_Message_proto_init() {
 				return nil
 			}
 		}
-		file_Message_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Contact); i {
+		file_Message_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Contact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiveLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Url); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Document); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ptt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Video); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1598,8 +4887,8 @@ func file_Message_proto_init() {
 				return nil
 			}
 		}
-		file_Message_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContactArray); i {
+		file_Message_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sticker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1610,8 +4899,8 @@ func file_Message_proto_init() {
 				return nil
 			}
 		}
-		file_Message_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Url); i {
+		file_Message_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContextInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1622,8 +4911,8 @@ func file_Message_proto_init() {
 				return nil
 			}
 		}
-		file_Message_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Document); i {
+		file_Message_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1634,8 +4923,8 @@ func file_Message_proto_init() {
 				return nil
 			}
 		}
-		file_Message_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ptt); i {
+		file_Message_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1646,8 +4935,8 @@ func file_Message_proto_init() {
 				return nil
 			}
 		}
-		file_Message_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Video); i {
+		file_Message_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1658,8 +4947,8 @@ func file_Message_proto_init() {
 				return nil
 			}
 		}
-		file_Message_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Sticker); i {
+		file_Message_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageContextInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1670,7 +4959,151 @@ func file_Message_proto_init() {
 				return nil
 			}
 		}
-		file_Message_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_Message_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollCreation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollEncValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ButtonsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ButtonsResponseMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResponseMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HydratedTemplateButton); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HydratedFourRowTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateButtonReplyMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Media); i {
 			case 0:
 				return &v.state
@@ -1682,7 +5115,7 @@ func file_Message_proto_init() {
 				return nil
 			}
 		}
-		file_Message_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_Message_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Message); i {
 			case 0:
 				return &v.state
@@ -1694,7 +5127,139 @@ func file_Message_proto_init() {
 				return nil
 			}
 		}
-		file_Message_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_Message_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FutureProofMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceSentMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollCreation_Option); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ButtonsMessage_Button); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ButtonsMessage_Button_ButtonText); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMessage_Row); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMessage_Section); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResponseMessage_SingleSelectReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HydratedTemplateButton_QuickReplyButton); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HydratedTemplateButton_UrlButton); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HydratedTemplateButton_CallButton); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Message_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Message_Group); i {
 			case 0:
 				return &v.state
@@ -1712,8 +5277,8 @@ func file_Message_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_Message_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      6,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
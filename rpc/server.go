@@ -9,7 +9,6 @@ import (
 	"wa/core"
 	"wa/def"
 	"wa/rpc/pb"
-	"wa/xmpp"
 
 	"github.com/pkg/errors"
 )
@@ -83,7 +82,7 @@ func (s *WaServer) Push(in *pb.Json, stream pb.Rpc_PushServer) (e error) {
 
 	// push notification
 	push_to_client := func(args ...any) error {
-		j := args[0].(*xmpp.Node).ToJson()
+		j := args[0].(core.Pushable).ToJson()
 		e := stream.Send(&pb.Json{
 			Data: core.NewJsonRet(j).ToString(),
 		})
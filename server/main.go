@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"time"
 	"wa/db"
 	"wa/def"
 	"wa/rpc"
 	"wa/rpc/pb"
+	"wa/signal/state/record"
 
 	"aconfig"
 
@@ -24,6 +26,13 @@ type Config struct {
 	LogLevel int
 	Pprof    bool
 	Port     int
+	MediaDir string // `path` of media rpc is restricted to this dir
+
+	// skipped message key limits, see record.Limits
+	MaxForwardJump      uint32
+	MaxMessageKeys      int
+	MaxReceiverChains   int
+	ReceiverChainExpiry int // in seconds, 0 means never expire
 }
 
 var cfg_fn = "server.toml"
@@ -36,6 +45,12 @@ func main() {
 		LogLevel: -1,
 		Pprof:    false,
 		Port:     3423,
+		MediaDir: "media",
+
+		MaxForwardJump:      record.DefaultLimits.MaxForwardJump,
+		MaxMessageKeys:      record.DefaultLimits.MaxMessageKeys,
+		MaxReceiverChains:   record.DefaultLimits.MaxReceiverChains,
+		ReceiverChainExpiry: int(record.DefaultLimits.ReceiverChainExpiry / time.Second),
 	}
 	aconfig.Load(cfg_fn, cfg)
 	aconfig.Save(cfg_fn, cfg)
@@ -53,6 +68,23 @@ func main() {
 		}()
 	}
 
+	e := record.SetLimits(record.Limits{
+		MaxForwardJump:      cfg.MaxForwardJump,
+		MaxMessageKeys:      cfg.MaxMessageKeys,
+		MaxReceiverChains:   cfg.MaxReceiverChains,
+		ReceiverChainExpiry: time.Duration(cfg.ReceiverChainExpiry) * time.Second,
+	})
+	if e != nil {
+		color.HiRed("invalid session limits: %v", e)
+		os.Exit(1)
+	}
+
+	def.MediaDir = cfg.MediaDir
+	if e := os.MkdirAll(def.MediaDir, 0755); e != nil {
+		color.HiRed("fail create media dir: %v", e)
+		os.Exit(1)
+	}
+
 	def.RpcPort = cfg.Port
 	listen()
 }
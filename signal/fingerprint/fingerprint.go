@@ -1,9 +1,10 @@
 package fingerprint
 
 // NewFingerprint will return a new Fingerprint structure.
-func NewFingerprint(displayFingerprint *Display) *Fingerprint {
+func NewFingerprint(displayFingerprint *Display, scannableFingerprint *Scannable) *Fingerprint {
 	return &Fingerprint{
 		fingerprintDisplay: displayFingerprint,
+		fingerprintScan:    scannableFingerprint,
 	}
 }
 
@@ -11,7 +12,7 @@ func NewFingerprint(displayFingerprint *Display) *Fingerprint {
 // fingerprint for identity verification.
 type Fingerprint struct {
 	fingerprintDisplay *Display
-	fingerprintScan    string
+	fingerprintScan    *Scannable
 }
 
 // Display will return a fingerprint display structure for getting a
@@ -22,6 +23,6 @@ func (f *Fingerprint) Display() *Display {
 
 // Scan will return a fingerprint scan structure for getting a scannable
 // representation of given keys.
-func (f *Fingerprint) Scan() string {
+func (f *Fingerprint) Scan() *Scannable {
 	return f.fingerprintScan
 }
@@ -0,0 +1,80 @@
+package fingerprint
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+
+	"wa/signal/keys/identity"
+)
+
+// fingerprintVersion is the version prefix of the hashed data.
+const fingerprintVersion uint16 = 0
+
+// NewNumericFingerprintGenerator will return a new generator that
+// hashes the identity keys with the given number of iterations.
+// WhatsApp/Signal use 5200 iterations.
+func NewNumericFingerprintGenerator(iterations int) *NumericFingerprintGenerator {
+	return &NumericFingerprintGenerator{
+		iterations: iterations,
+	}
+}
+
+// NumericFingerprintGenerator is a structure for generating numeric
+// (60 digits) fingerprints.
+type NumericFingerprintGenerator struct {
+	iterations int
+}
+
+// CreateFor will return a fingerprint for the given local and remote identities.
+func (n *NumericFingerprintGenerator) CreateFor(localStableIdentifier, remoteStableIdentifier string,
+	localIdentityKey, remoteIdentityKey *identity.Key) *Fingerprint {
+
+	return n.CreateForMultiple(
+		localStableIdentifier, remoteStableIdentifier,
+		[]*identity.Key{localIdentityKey}, []*identity.Key{remoteIdentityKey},
+	)
+}
+
+// CreateForMultiple will return a fingerprint for the given local and remote
+// identities, each side may have multiple identity keys.
+func (n *NumericFingerprintGenerator) CreateForMultiple(localStableIdentifier, remoteStableIdentifier string,
+	localIdentityKeys, remoteIdentityKeys []*identity.Key) *Fingerprint {
+
+	localFingerprint := n.getFingerprint(localStableIdentifier, localIdentityKeys)
+	remoteFingerprint := n.getFingerprint(remoteStableIdentifier, remoteIdentityKeys)
+
+	return NewFingerprint(
+		NewDisplay(localFingerprint, remoteFingerprint),
+		NewScannable(localFingerprint, remoteFingerprint),
+	)
+}
+
+// getFingerprint will iteratively hash the identity keys with the
+// stable identifier.
+func (n *NumericFingerprintGenerator) getFingerprint(stableIdentifier string, keys []*identity.Key) []byte {
+	publicKey := logicalKeyBytes(keys)
+
+	version := make([]byte, 2)
+	binary.BigEndian.PutUint16(version, fingerprintVersion)
+
+	hash := append(version, publicKey...)
+	hash = append(hash, []byte(stableIdentifier)...)
+
+	for i := 0; i < n.iterations; i++ {
+		digest := sha512.New()
+		digest.Write(hash)
+		digest.Write(publicKey)
+		hash = digest.Sum(nil)
+	}
+
+	return hash
+}
+
+// logicalKeyBytes will concatenate all the serialized keys.
+func logicalKeyBytes(keys []*identity.Key) []byte {
+	var ret []byte
+	for _, key := range keys {
+		ret = append(ret, key.Serialize()...)
+	}
+	return ret
+}
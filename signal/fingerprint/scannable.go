@@ -0,0 +1,63 @@
+package fingerprint
+
+import (
+	"crypto/subtle"
+	"errors"
+
+	"wa/signal/pb"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// scannableVersion is the version of CombinedFingerprints.
+const scannableVersion uint32 = 1
+
+// ErrVersionMismatch is returned when comparing with a scanned
+// fingerprint of different version.
+var ErrVersionMismatch = errors.New("fingerprint version mismatch")
+
+// NewScannable will return a new scannable fingerprint, only the first
+// 32 bytes of each fingerprint are used.
+func NewScannable(localFingerprint, remoteFingerprint []byte) *Scannable {
+	return &Scannable{
+		version:           scannableVersion,
+		localFingerprint:  localFingerprint[:32],
+		remoteFingerprint: remoteFingerprint[:32],
+	}
+}
+
+// Scannable is a structure for the QR code payload of fingerprints.
+type Scannable struct {
+	version           uint32
+	localFingerprint  []byte
+	remoteFingerprint []byte
+}
+
+// Serialize will return the payload to be encoded as QR code.
+func (s *Scannable) Serialize() ([]byte, error) {
+	return proto.Marshal(&pb.CombinedFingerprints{
+		Version: proto.Uint32(s.version),
+		LocalFingerprint: &pb.LogicalFingerprint{
+			Content: s.localFingerprint,
+		},
+		RemoteFingerprint: &pb.LogicalFingerprint{
+			Content: s.remoteFingerprint,
+		},
+	})
+}
+
+// CompareTo will compare with the payload scanned from the remote device.
+// The remote's local fingerprint should equal to our remote fingerprint
+// and vice versa.
+func (s *Scannable) CompareTo(scanned []byte) (bool, error) {
+	combined := &pb.CombinedFingerprints{}
+	if err := proto.Unmarshal(scanned, combined); err != nil {
+		return false, err
+	}
+	if combined.GetVersion() != s.version {
+		return false, ErrVersionMismatch
+	}
+
+	return subtle.ConstantTimeCompare(s.localFingerprint, combined.GetRemoteFingerprint().GetContent()) == 1 &&
+		subtle.ConstantTimeCompare(s.remoteFingerprint, combined.GetLocalFingerprint().GetContent()) == 1, nil
+}
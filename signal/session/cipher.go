@@ -2,7 +2,6 @@ package session
 
 import (
 	"errors"
-	"strconv"
 
 	"wa/signal/cipher"
 	"wa/signal/ecc"
@@ -14,8 +13,6 @@ import (
 	"wa/signal/util/bytehelper"
 )
 
-const maxFutureMessages = 5000
-
 // NewCipher constructs a session cipher for encrypt/decrypt operations on a
 // session. In order to use the session cipher, a session must have already
 // been created and stored using session.Builder.
@@ -206,6 +203,13 @@ func (d *Cipher) DecryptWithRecord(sessionRecord *record.Session, ciphertext *pr
 	// If we received an error using the current session state, loop
 	// through all previous states.
 	if err != nil {
+		// A duplicate message can't be decrypted by older states either.
+		var oldCounter *OldCounterError
+		if errors.As(err, &oldCounter) {
+			return nil, nil, err
+		}
+		currentErr := err
+
 		for i, state := range previousStates {
 			// Try decrypting the message with previous states
 			plaintext, messageKeys, err = d.DecryptWithState(state, ciphertext)
@@ -220,6 +224,11 @@ func (d *Cipher) DecryptWithRecord(sessionRecord *record.Session, ciphertext *pr
 			return plaintext, messageKeys, nil
 		}
 
+		// Keep the limit error of the current state, so caller knows why.
+		var forwardJump *ForwardJumpError
+		if errors.As(currentErr, &forwardJump) {
+			return nil, nil, currentErr
+		}
 		return nil, nil, errors.New("No valid sessions.")
 	}
 
@@ -276,15 +285,18 @@ func getOrCreateMessageKeys(sessionState *record.State, theirEphemeral ecc.ECPub
 		if sessionState.HasMessageKeys(theirEphemeral, counter) {
 			return sessionState.RemoveMessageKeys(theirEphemeral, counter), nil
 		}
-		index := strconv.FormatUint(uint64(chainKey.Index()), 10)
-		count := strconv.FormatUint(uint64(counter), 10)
-		return nil, errors.New(
-			"Received message with old counter: " + index + " , " + count,
-		)
+		return nil, &OldCounterError{
+			ChainIndex: chainKey.Index(),
+			Counter:    counter,
+		}
 	}
 
-	if counter-chainKey.Index() > maxFutureMessages {
-		return nil, errors.New("Too many messages into the future!")
+	if maxJump := record.GetLimits().MaxForwardJump; counter-chainKey.Index() > maxJump {
+		return nil, &ForwardJumpError{
+			ChainIndex: chainKey.Index(),
+			Counter:    counter,
+			Max:        maxJump,
+		}
 	}
 
 	for chainKey.Index() < counter {
@@ -0,0 +1,31 @@
+package session
+
+import (
+	"fmt"
+)
+
+// ForwardJumpError is returned when a message skips more messages than
+// record.Limits.MaxForwardJump in a single chain.
+type ForwardJumpError struct {
+	ChainIndex uint32 // the next expected counter
+	Counter    uint32 // the counter of the received message
+	Max        uint32
+}
+
+func (e *ForwardJumpError) Error() string {
+	return fmt.Sprintf("Too many messages into the future: %d -> %d, max jump %d",
+		e.ChainIndex, e.Counter, e.Max)
+}
+
+// OldCounterError is returned when a message has an old counter but its key
+// is not cached: the message is a duplicate, or its key has been dropped by
+// record.Limits.MaxMessageKeys.
+type OldCounterError struct {
+	ChainIndex uint32
+	Counter    uint32
+}
+
+func (e *OldCounterError) Error() string {
+	return fmt.Sprintf("Received message with old counter: %d , %d",
+		e.ChainIndex, e.Counter)
+}
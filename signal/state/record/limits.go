@@ -0,0 +1,77 @@
+package record
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// Limits bounds the message keys kept for out-of-order messages.
+type Limits struct {
+	// MaxForwardJump is the max number of messages that can be skipped
+	// in a single chain.
+	MaxForwardJump uint32
+
+	// MaxMessageKeys is the max number of skipped message keys cached
+	// per receiver chain, the oldest ones are dropped first.
+	MaxMessageKeys int
+
+	// MaxReceiverChains is the max number of receiver chains kept
+	// in a session state, the oldest ones are dropped first.
+	MaxReceiverChains int
+
+	// ReceiverChainExpiry drops the receiver chains that haven't received
+	// any message for this long, together with their cached message keys.
+	// Zero means never expire.
+	ReceiverChainExpiry time.Duration
+}
+
+// DefaultLimits are the limits used if not set by SetLimits.
+var DefaultLimits = Limits{
+	MaxForwardJump:      5000,
+	MaxMessageKeys:      2000,
+	MaxReceiverChains:   5,
+	ReceiverChainExpiry: 0,
+}
+
+var (
+	limitsMu sync.RWMutex
+	limits   = DefaultLimits
+)
+
+// Validate checks every limit is at least 1, a zero MaxReceiverChains
+// would drop the chain that was just added.
+func (l Limits) Validate() error {
+	if l.MaxForwardJump < 1 {
+		return errors.New("MaxForwardJump must be >= 1")
+	}
+	if l.MaxMessageKeys < 1 {
+		return errors.New("MaxMessageKeys must be >= 1")
+	}
+	if l.MaxReceiverChains < 1 {
+		return errors.New("MaxReceiverChains must be >= 1")
+	}
+	if l.ReceiverChainExpiry < 0 {
+		return errors.New("ReceiverChainExpiry must be >= 0")
+	}
+	return nil
+}
+
+// SetLimits will set the limits for all sessions,
+// invalid limits are rejected and the current ones are kept.
+func SetLimits(l Limits) error {
+	if e := l.Validate(); e != nil {
+		return e
+	}
+	limitsMu.Lock()
+	defer limitsMu.Unlock()
+	limits = l
+	return nil
+}
+
+// GetLimits returns the current limits.
+func GetLimits() Limits {
+	limitsMu.RLock()
+	defer limitsMu.RUnlock()
+	return limits
+}
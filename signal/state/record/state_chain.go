@@ -1,12 +1,16 @@
 package record
 
 import (
+	"time"
+
 	"wa/signal/ecc"
 	"wa/signal/kdf"
 	"wa/signal/keys/chain"
 	"wa/signal/keys/message"
 	"wa/signal/pb"
 	"wa/signal/util/bytehelper"
+
+	"google.golang.org/protobuf/proto"
 )
 
 // NewReceiverChainPair will return a new ReceiverChainPair object.
@@ -63,6 +67,7 @@ func NewChainFromStructure(structure *pb.SessionStructure_Chain) (*Chain, error)
 		chain.NewKeyFromStruct(structure.ChainKey, kdf.DeriveSecrets),
 		messageKeys,
 	)
+	chainState.updatedAt = structure.GetUpdatedAt()
 
 	return chainState, nil
 }
@@ -73,6 +78,7 @@ type Chain struct {
 	senderRatchetKeyPair *ecc.ECKeyPair
 	chainKey             *chain.Key
 	messageKeys          []*message.Keys
+	updatedAt            int64 // unix time
 }
 
 // SenderRatchetKey returns the sender's EC keypair.
@@ -96,6 +102,20 @@ func (c *Chain) SetChainKey(key *chain.Key) {
 	c.chainKey = key
 }
 
+// UpdatedAt will return the time of the last received message
+// in this chain, zero if unknown.
+func (c *Chain) UpdatedAt() time.Time {
+	if c.updatedAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(c.updatedAt, 0)
+}
+
+// Touch will set the last received time of the chain to now.
+func (c *Chain) Touch() {
+	c.updatedAt = time.Now().Unix()
+}
+
 // MessageKeys will return the message keys associated with the
 // chain state.
 func (c *Chain) MessageKeys() []*message.Keys {
@@ -146,5 +166,6 @@ func (c *Chain) structure() *pb.SessionStructure_Chain {
 		SenderRatchetKeyPrivate: senderRatchetKeyPrivate,
 		ChainKey:                chain.NewStructFromKey(c.chainKey),
 		MessageKeys:             messageKeys,
+		UpdatedAt:               proto.Int64(c.updatedAt),
 	}
 }
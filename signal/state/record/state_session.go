@@ -1,6 +1,8 @@
 package record
 
 import (
+	"time"
+
 	"wa/signal/ecc"
 	"wa/signal/kdf"
 	"wa/signal/keys/chain"
@@ -15,9 +17,6 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-const maxMessageKeys int = 2000
-const maxReceiverChains int = 5
-
 // NewStateFromBytes will return a Signal State from the given
 // bytes using the given serializer.
 func NewStateFromBytes(serialized []byte) (*State, error) {
@@ -241,15 +240,42 @@ func (s *State) AddReceiverChain(senderRatchetKey ecc.ECPublicKeyable, chainKey
 	// Create a Chain state object that will hold our sender key, chain key, and
 	// message keys.
 	receiverChain := NewChain(senderKey, chainKey.(*chain.Key), []*message.Keys{})
+	receiverChain.Touch()
+
+	// Drop the chains that haven't been used for a long time.
+	s.ExpireReceiverChains(time.Now())
 
 	// Add the Chain state to our list of receiver chain states.
 	s.receiverChains = append(s.receiverChains, receiverChain)
 
 	// If our list of receiver chains is too big, delete the oldest entry.
-	if len(s.receiverChains) > maxReceiverChains {
-		i := 0
-		s.receiverChains = append(s.receiverChains[:i], s.receiverChains[i+1:]...)
+	for len(s.receiverChains) > GetLimits().MaxReceiverChains {
+		s.receiverChains = s.receiverChains[1:]
+	}
+}
+
+// ReceiverChains returns all the receiver chains of the session state.
+func (s *State) ReceiverChains() []*Chain {
+	return s.receiverChains
+}
+
+// ExpireReceiverChains will remove the receiver chains that haven't received
+// any message since ReceiverChainExpiry before now. Chains without
+// timestamp are kept.
+func (s *State) ExpireReceiverChains(now time.Time) {
+	expiry := GetLimits().ReceiverChainExpiry
+	if expiry <= 0 {
+		return
+	}
+
+	kept := s.receiverChains[:0]
+	for _, c := range s.receiverChains {
+		if c.updatedAt != 0 && now.Sub(c.UpdatedAt()) > expiry {
+			continue
+		}
+		kept = append(kept, c)
 	}
+	s.receiverChains = kept
 }
 
 // SetSenderChain will set the given ratchet key pair and chain key for this session
@@ -357,7 +383,7 @@ func (s *State) SetMessageKeys(senderEphemeral ecc.ECPublicKeyable, messageKeys
 		),
 	)
 
-	if len(chainState.MessageKeys()) > maxMessageKeys {
+	for len(chainState.MessageKeys()) > GetLimits().MaxMessageKeys {
 		chainState.PopFirstMessageKeys()
 	}
 }
@@ -368,6 +394,7 @@ func (s *State) SetReceiverChainKey(senderEphemeral ecc.ECPublicKeyable, chainKe
 	chainAndIndex := s.receiverChain(senderEphemeral)
 	chainState := chainAndIndex.ReceiverChain
 	chainState.SetChainKey(chainKey.(*chain.Key))
+	chainState.Touch()
 }
 
 // SetPendingKeyExchange will set the session's pending key exchange state to the given
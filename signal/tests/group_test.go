@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+const groupID = "123-456@g.us"
+
+func groupReceive(t *testing.T, to, sender *Party, skmsg []byte, want string) {
+	t.Helper()
+	plain, e := to.GroupDecrypt(groupID, sender, skmsg)
+	if e != nil {
+		t.Fatalf("%s group decrypt: %v", to.Name, e)
+	}
+	if !bytes.Equal(plain, []byte(want)) {
+		t.Fatalf("%s decrypted %q, want %q", to.Name, plain, want)
+	}
+}
+
+// the skdm is delivered in a pairwise session, as SendGroupMsg does
+func distribute(t *testing.T, sender, to *Party) {
+	t.Helper()
+	skdm, e := sender.CreateSenderKey(groupID)
+	if e != nil {
+		t.Fatal(e)
+	}
+	data, msgType, e := sender.Encrypt(to, skdm)
+	if e != nil {
+		t.Fatal(e)
+	}
+	plain, e := to.Decrypt(sender, data, msgType)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if e := to.ProcessSenderKey(groupID, sender, plain); e != nil {
+		t.Fatal(e)
+	}
+}
+
+func TestGroupMessage(t *testing.T) {
+	alice, bob := newPair(t)
+	carol, e := NewParty("carol", 1)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if e := alice.StartSession(bob); e != nil {
+		t.Fatal(e)
+	}
+	if e := alice.StartSession(carol); e != nil {
+		t.Fatal(e)
+	}
+	distribute(t, alice, bob)
+	distribute(t, alice, carol)
+
+	for i := 0; i < 5; i++ {
+		msg := fmt.Sprintf("group %d", i)
+		skmsg, e := alice.GroupEncrypt(groupID, []byte(msg))
+		if e != nil {
+			t.Fatal(e)
+		}
+		groupReceive(t, bob, alice, skmsg, msg)
+		groupReceive(t, carol, alice, skmsg, msg)
+	}
+}
+
+func TestGroupOutOfOrder(t *testing.T) {
+	alice, bob := newPair(t)
+	if e := alice.StartSession(bob); e != nil {
+		t.Fatal(e)
+	}
+	distribute(t, alice, bob)
+
+	var skmsgs [][]byte
+	for i := 0; i < 10; i++ {
+		skmsg, e := alice.GroupEncrypt(groupID, []byte(fmt.Sprintf("group %d", i)))
+		if e != nil {
+			t.Fatal(e)
+		}
+		skmsgs = append(skmsgs, skmsg)
+	}
+	for i := len(skmsgs) - 1; i >= 0; i-- {
+		groupReceive(t, bob, alice, skmsgs[i], fmt.Sprintf("group %d", i))
+	}
+
+	// duplicate
+	if _, e := bob.GroupDecrypt(groupID, alice, skmsgs[0]); e == nil {
+		t.Fatal("duplicate group message decrypted")
+	}
+}
+
+func TestGroupNoSenderKey(t *testing.T) {
+	alice, bob := newPair(t)
+
+	// never distributed to bob
+	if _, e := alice.CreateSenderKey(groupID); e != nil {
+		t.Fatal(e)
+	}
+	skmsg, e := alice.GroupEncrypt(groupID, []byte("secret"))
+	if e != nil {
+		t.Fatal(e)
+	}
+	if _, e := bob.GroupDecrypt(groupID, alice, skmsg); e == nil {
+		t.Fatal("decrypted without sender key")
+	}
+}
+
+// a removed member can't read messages after the sender key rotated
+func TestGroupSenderKeyRotation(t *testing.T) {
+	alice, bob := newPair(t)
+	carol, e := NewParty("carol", 1)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if e := alice.StartSession(bob); e != nil {
+		t.Fatal(e)
+	}
+	if e := alice.StartSession(carol); e != nil {
+		t.Fatal(e)
+	}
+	distribute(t, alice, bob)
+	distribute(t, alice, carol)
+
+	// carol removed
+	alice.Store.DeleteSenderKey(alice.senderKeyName(groupID, alice))
+	distribute(t, alice, bob)
+
+	skmsg, e := alice.GroupEncrypt(groupID, []byte("after rotation"))
+	if e != nil {
+		t.Fatal(e)
+	}
+	groupReceive(t, bob, alice, skmsg, "after rotation")
+
+	if _, e := carol.GroupDecrypt(groupID, alice, skmsg); e == nil {
+		t.Fatal("removed member decrypted message with new sender key")
+	}
+}
@@ -0,0 +1,212 @@
+// Package tests provides an in-memory implementation of
+// store.SignalProtocol and a two party harness for testing the protocol.
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+
+	groupRecord "wa/signal/groups/state/record"
+	"wa/signal/keys/identity"
+	"wa/signal/protocol"
+	"wa/signal/state/record"
+)
+
+// NewInMemory returns an empty store for the given identity.
+func NewInMemory(identityKeyPair *identity.KeyPair, registrationID uint32) *InMemory {
+	return &InMemory{
+		identityKeyPair: identityKeyPair,
+		registrationID:  registrationID,
+		identities:      map[string]*identity.Key{},
+		preKeys:         map[uint32][]byte{},
+		signedPreKeys:   map[uint32][]byte{},
+		sessions:        map[string][]byte{},
+		senderKeys:      map[string][]byte{},
+	}
+}
+
+// InMemory implements store.SignalProtocol, records are kept serialized,
+// so they go through the same (de)serialization as a persistent store.
+type InMemory struct {
+	mu sync.Mutex
+
+	identityKeyPair *identity.KeyPair
+	registrationID  uint32
+
+	identities    map[string]*identity.Key
+	preKeys       map[uint32][]byte
+	signedPreKeys map[uint32][]byte
+	sessions      map[string][]byte
+	senderKeys    map[string][]byte
+}
+
+// identity
+
+func (s *InMemory) GetIdentityKeyPair() (*identity.KeyPair, error) {
+	return s.identityKeyPair, nil
+}
+func (s *InMemory) GetLocalRegistrationId() (uint32, error) {
+	return s.registrationID, nil
+}
+func (s *InMemory) SaveIdentity(address *protocol.SignalAddress, identityKey *identity.Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.identities[address.String()] = identityKey
+	return nil
+}
+
+// IsTrustedIdentity trusts on first use.
+func (s *InMemory) IsTrustedIdentity(address *protocol.SignalAddress, identityKey *identity.Key) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	trusted, ok := s.identities[address.String()]
+	if !ok {
+		return true
+	}
+	return bytes.Equal(trusted.Serialize(), identityKey.Serialize())
+}
+
+// prekey
+
+func (s *InMemory) LoadPreKey(preKeyID uint32) (*record.PreKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ser, ok := s.preKeys[preKeyID]
+	if !ok {
+		return nil, errors.New("No such prekey")
+	}
+	return record.NewPreKeyFromBytes(ser)
+}
+func (s *InMemory) StorePreKey(preKeyID uint32, preKeyRecord *record.PreKey) error {
+	ser, e := preKeyRecord.Serialize()
+	if e != nil {
+		return e
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.preKeys[preKeyID] = ser
+	return nil
+}
+func (s *InMemory) ContainsPreKey(preKeyID uint32) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.preKeys[preKeyID]
+	return ok
+}
+func (s *InMemory) RemovePreKey(preKeyID uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.preKeys, preKeyID)
+}
+
+// signed prekey
+
+func (s *InMemory) LoadSignedPreKey(signedPreKeyID uint32) (*record.SignedPreKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ser, ok := s.signedPreKeys[signedPreKeyID]
+	if !ok {
+		return nil, errors.New("No such signed prekey")
+	}
+	return record.NewSignedPreKeyFromBytes(ser)
+}
+func (s *InMemory) LoadSignedPreKeys() []*record.SignedPreKey {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ret []*record.SignedPreKey
+	for _, ser := range s.signedPreKeys {
+		if spk, e := record.NewSignedPreKeyFromBytes(ser); e == nil {
+			ret = append(ret, spk)
+		}
+	}
+	return ret
+}
+func (s *InMemory) StoreSignedPreKey(signedPreKeyID uint32, record *record.SignedPreKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.signedPreKeys[signedPreKeyID] = record.Serialize()
+	return nil
+}
+func (s *InMemory) ContainsSignedPreKey(signedPreKeyID uint32) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.signedPreKeys[signedPreKeyID]
+	return ok
+}
+func (s *InMemory) RemoveSignedPreKey(signedPreKeyID uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.signedPreKeys, signedPreKeyID)
+}
+
+// session
+
+func (s *InMemory) LoadSession(address *protocol.SignalAddress) (*record.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ser, ok := s.sessions[address.String()]
+	if !ok {
+		return record.NewSession(), nil
+	}
+	return record.NewSessionFromBytes(ser)
+}
+func (s *InMemory) GetSubDeviceSessions(name string) []uint32 {
+	return nil
+}
+func (s *InMemory) StoreSession(remoteAddress *protocol.SignalAddress, record *record.Session) error {
+	ser, e := record.Serialize()
+	if e != nil {
+		return e
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[remoteAddress.String()] = ser
+	return nil
+}
+func (s *InMemory) ContainsSession(remoteAddress *protocol.SignalAddress) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.sessions[remoteAddress.String()]
+	return ok
+}
+func (s *InMemory) DeleteSession(remoteAddress *protocol.SignalAddress) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, remoteAddress.String())
+}
+func (s *InMemory) DeleteAllSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = map[string][]byte{}
+}
+
+// sender key
+
+func senderKeyID(senderKeyName *protocol.SenderKeyName) string {
+	return senderKeyName.GroupID() + "|" + senderKeyName.Sender().String()
+}
+func (s *InMemory) StoreSenderKey(senderKeyName *protocol.SenderKeyName, keyRecord *groupRecord.SenderKey) error {
+	ser, e := keyRecord.Serialize()
+	if e != nil {
+		return e
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.senderKeys[senderKeyID(senderKeyName)] = ser
+	return nil
+}
+func (s *InMemory) LoadSenderKey(senderKeyName *protocol.SenderKeyName) (*groupRecord.SenderKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ser, ok := s.senderKeys[senderKeyID(senderKeyName)]
+	if !ok {
+		return groupRecord.NewSenderKey(), nil
+	}
+	return groupRecord.NewSenderKeyFromBytes(ser)
+}
+func (s *InMemory) DeleteSenderKey(senderKeyName *protocol.SenderKeyName) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.senderKeys, senderKeyID(senderKeyName))
+}
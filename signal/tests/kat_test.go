@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"wa/signal/ecc"
+	"wa/signal/fingerprint"
+	"wa/signal/kdf"
+	"wa/signal/keys/chain"
+	"wa/signal/keys/identity"
+	"wa/signal/util/bytehelper"
+)
+
+// known-answer vectors from libsignal-protocol-java
+
+func unhex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, e := hex.DecodeString(s)
+	if e != nil {
+		t.Fatal(e)
+	}
+	return b
+}
+
+// RFC 5869, test case 1
+func TestKATHkdf(t *testing.T) {
+	ikm := unhex(t, "0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b")
+	salt := unhex(t, "000102030405060708090a0b0c")
+	info := unhex(t, "f0f1f2f3f4f5f6f7f8f9")
+	okm := unhex(t, "3cb25f25faacd57a90434f64d0362f2a2d2d0a90cf1a5a4c5db02d56ecc4c5bf34007208d5b887185865")
+
+	out, e := kdf.DeriveSecrets(ikm, salt, info, 42)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if !bytes.Equal(out, okm) {
+		t.Fatalf("got %x", out)
+	}
+}
+
+// Curve25519Test.testAgreement
+func TestKATAgreement(t *testing.T) {
+	alicePublic := unhex(t, "051bb75966f2e93a3691dfff942bb2a466a1c08b8d78ca3f4d6df8b8bfa2e4ee28")
+	alicePrivate := unhex(t, "c806439dc9d2c476ffed8f2580c0888d58ab406bf7ae3698879021b96bb4bf59")
+	bobPublic := unhex(t, "05653614993d2b15ee9e5fd3d86ce719ef4ec1daae1886a87b3f5fa9565a27a22f")
+	bobPrivate := unhex(t, "b03b34c33a1c44f225b662d2bf4859b8135411fa7b0386d45fb75dc5b91b4466")
+	shared := unhex(t, "325f239328941ced6e673b86ba41017448e99b649a9c3806c1dd7ca4c477e629")
+
+	alicePub, e := ecc.DecodePoint(alicePublic, 0)
+	if e != nil {
+		t.Fatal(e)
+	}
+	bobPub, e := ecc.DecodePoint(bobPublic, 0)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	s1 := kdf.CalculateSharedSecret(bobPub.PublicKey(), bytehelper.SliceToArray(alicePrivate))
+	s2 := kdf.CalculateSharedSecret(alicePub.PublicKey(), bytehelper.SliceToArray(bobPrivate))
+	if !bytes.Equal(s1[:], shared) || !bytes.Equal(s2[:], shared) {
+		t.Fatalf("got %x, %x", s1, s2)
+	}
+}
+
+// ChainKeyTest.testChainKeyDerivationV3
+func TestKATChainKey(t *testing.T) {
+	seed := unhex(t, "8ab72d6f4cc5ac0d387eaf463378ddb28edd07385b1cb01250c715982e7ad48f")
+	messageKey := unhex(t, "bf51e9d75e0e31031051f82a2491ffc084fa298b7793bd9db620056febf45217")
+	macKey := unhex(t, "c6c77d6a73a354337a56435e34607dfe48e3ace14e77314dc6abc172e7a7030b")
+	nextChainKey := unhex(t, "28e8f8fee54b801eef7c5cfb2f17f32c7b334485bbb70fac6ec10342a246d15d")
+
+	ck := chain.NewKey(kdf.DeriveSecrets, seed, 0)
+	mk := ck.MessageKeys()
+
+	if !bytes.Equal(mk.CipherKey(), messageKey) {
+		t.Fatalf("cipher key %x", mk.CipherKey())
+	}
+	if !bytes.Equal(mk.MacKey(), macKey) {
+		t.Fatalf("mac key %x", mk.MacKey())
+	}
+	next := ck.NextKey()
+	if !bytes.Equal(next.Key(), nextChainKey) {
+		t.Fatalf("next chain key %x", next.Key())
+	}
+	if next.Index() != 1 || next.MessageKeys().Index() != 1 {
+		t.Fatal("wrong index")
+	}
+}
+
+// NumericFingerprintGeneratorTest.testVectorsVersion1
+func TestKATFingerprint(t *testing.T) {
+	aliceIdentity := unhex(t, "0506863bc66d02b40d27b8d49ca7c09e9239236f9d7d25d6fcca5ce13c7064d868")
+	bobIdentity := unhex(t, "05f781b6fb32fed9ba1cf2de978d4d5da28dc34046ae814402b5c0dbd96fda907b")
+	display := "300354477692869396892869876765458257569162576843440918079131"
+
+	alicePub, e := ecc.DecodePoint(aliceIdentity, 0)
+	if e != nil {
+		t.Fatal(e)
+	}
+	bobPub, e := ecc.DecodePoint(bobIdentity, 0)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	g := fingerprint.NewNumericFingerprintGenerator(5200)
+	aliceFp := g.CreateFor("+14152222222", "+14153333333",
+		identity.NewKey(alicePub), identity.NewKey(bobPub))
+	bobFp := g.CreateFor("+14153333333", "+14152222222",
+		identity.NewKey(bobPub), identity.NewKey(alicePub))
+
+	if got := aliceFp.Display().DisplayText(); got != display {
+		t.Fatalf("alice display %s", got)
+	}
+	if got := bobFp.Display().DisplayText(); got != display {
+		t.Fatalf("bob display %s", got)
+	}
+
+	bobScan, e := bobFp.Scan().Serialize()
+	if e != nil {
+		t.Fatal(e)
+	}
+	match, e := aliceFp.Scan().CompareTo(bobScan)
+	if e != nil || !match {
+		t.Fatalf("scannable mismatch: %v", e)
+	}
+}
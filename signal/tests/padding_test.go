@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"bytes"
+	"testing"
+
+	"wa/crypto"
+)
+
+func TestPadding(t *testing.T) {
+	for n := 0; n < 64; n++ {
+		msg := bytes.Repeat([]byte{0x0f}, n)
+		padded := crypto.RandomPadMsg(msg)
+
+		pad := len(padded) - n
+		if pad < 1 || pad > 15 {
+			t.Fatalf("pad length %d", pad)
+		}
+		unpadded, e := crypto.UnPadMsg(padded)
+		if e != nil {
+			t.Fatal(e)
+		}
+		if !bytes.Equal(unpadded, msg) {
+			t.Fatalf("got %x, want %x", unpadded, msg)
+		}
+	}
+}
+
+func TestUnPadInvalid(t *testing.T) {
+	for _, data := range [][]byte{
+		{},
+		{0x05},
+		{0x01, 0x03, 0x03},
+		{0x01, 0x02, 0x02, 0x03},
+	} {
+		if _, e := crypto.UnPadMsg(data); e == nil {
+			t.Fatalf("unpadded invalid data %x", data)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package tests
+
+import (
+	"errors"
+
+	"wa/signal/groups"
+	"wa/signal/keys/identity"
+	"wa/signal/keys/prekey"
+	"wa/signal/protocol"
+	"wa/signal/session"
+	"wa/signal/util/keyhelper"
+	"wa/signal/util/optional"
+)
+
+const (
+	preKeyCount    = 100
+	signedPreKeyID = 1
+)
+
+// Party is one side of a conversation, eg: Alice or Bob.
+type Party struct {
+	Name    string
+	Address *protocol.SignalAddress
+	Store   *InMemory
+
+	nextPreKeyID uint32
+}
+
+// NewParty generates the identity, prekeys and signed prekey of a party.
+func NewParty(name string, deviceID uint32) (*Party, error) {
+	p := &Party{
+		Name:         name,
+		Address:      protocol.NewSignalAddress(name, deviceID),
+		nextPreKeyID: 1,
+	}
+	if e := p.Reinstall(); e != nil {
+		return nil, e
+	}
+	return p, nil
+}
+
+// Reinstall drops everything and generates a new identity,
+// like the app is reinstalled.
+func (p *Party) Reinstall() error {
+	identityKeyPair, e := keyhelper.GenerateIdentityKeyPair()
+	if e != nil {
+		return e
+	}
+	p.Store = NewInMemory(identityKeyPair, keyhelper.GenerateRegistrationID())
+
+	preKeys, e := keyhelper.GeneratePreKeys(1, preKeyCount)
+	if e != nil {
+		return e
+	}
+	for _, pk := range preKeys {
+		if e := p.Store.StorePreKey(pk.ID().Value, pk); e != nil {
+			return e
+		}
+	}
+	spk, e := keyhelper.GenerateSignedPreKey(identityKeyPair, signedPreKeyID)
+	if e != nil {
+		return e
+	}
+	p.nextPreKeyID = 1
+	return p.Store.StoreSignedPreKey(signedPreKeyID, spk)
+}
+
+// IdentityKey returns the public identity key.
+func (p *Party) IdentityKey() *identity.Key {
+	ikp, _ := p.Store.GetIdentityKeyPair()
+	return ikp.PublicKey()
+}
+
+// Bundle returns the prekey bundle as the server would hand out,
+// each call consumes a one-time prekey.
+func (p *Party) Bundle() (*prekey.Bundle, error) {
+	if p.nextPreKeyID > preKeyCount {
+		return nil, errors.New("No prekey left")
+	}
+	pk, e := p.Store.LoadPreKey(p.nextPreKeyID)
+	if e != nil {
+		return nil, e
+	}
+	p.nextPreKeyID++
+
+	spk, e := p.Store.LoadSignedPreKey(signedPreKeyID)
+	if e != nil {
+		return nil, e
+	}
+	regID, _ := p.Store.GetLocalRegistrationId()
+
+	return prekey.NewBundle(
+		regID,
+		p.Address.DeviceID(),
+		optional.NewOptionalUint32(pk.ID().Value),
+		spk.ID(),
+		pk.KeyPair().PublicKey(),
+		spk.KeyPair().PublicKey(),
+		spk.Signature(),
+		p.IdentityKey(),
+	), nil
+}
+
+func (p *Party) builder(remote *Party) *session.Builder {
+	return session.NewBuilderFromSignal(p.Store, remote.Address)
+}
+
+// Cipher returns the session cipher for `remote`.
+func (p *Party) Cipher(remote *Party) *session.Cipher {
+	return session.NewCipher(p.builder(remote), remote.Address)
+}
+
+// StartSession fetches the bundle of `remote` and builds the session.
+func (p *Party) StartSession(remote *Party) error {
+	bundle, e := remote.Bundle()
+	if e != nil {
+		return e
+	}
+	return p.builder(remote).ProcessBundle(bundle)
+}
+
+// Encrypt a message to `remote`, returns the serialized message and its type.
+func (p *Party) Encrypt(remote *Party, plaintext []byte) ([]byte, uint32, error) {
+	msg, e := p.Cipher(remote).Encrypt(plaintext)
+	if e != nil {
+		return nil, 0, e
+	}
+	return msg.Serialize(), msg.Type(), nil
+}
+
+// Decrypt a message from `remote`, the same way `pkmsg`/`msg` are handled.
+func (p *Party) Decrypt(remote *Party, ciphertext []byte, msgType uint32) ([]byte, error) {
+	sc := p.Cipher(remote)
+
+	switch msgType {
+	case protocol.PREKEY_TYPE:
+		pkm, e := protocol.NewPreKeySignalMessageFromBytes(ciphertext)
+		if e != nil {
+			return nil, e
+		}
+		return sc.DecryptMessage(pkm)
+	case protocol.WHISPER_TYPE:
+		wm, e := protocol.NewSignalMessageFromBytes(ciphertext)
+		if e != nil {
+			return nil, e
+		}
+		return sc.Decrypt(wm)
+	}
+	return nil, errors.New("Unknown message type")
+}
+
+func (p *Party) senderKeyName(groupID string, sender *Party) *protocol.SenderKeyName {
+	return protocol.NewSenderKeyName(groupID, sender.Address)
+}
+
+// CreateSenderKey returns our serialized SenderKeyDistributionMessage
+// of the group, a new sender key is generated if not exists.
+func (p *Party) CreateSenderKey(groupID string) ([]byte, error) {
+	gsb := groups.NewGroupSessionBuilder(p.Store)
+	skdm, e := gsb.Create(p.senderKeyName(groupID, p))
+	if e != nil {
+		return nil, e
+	}
+	return skdm.Serialize(), nil
+}
+
+// ProcessSenderKey processes the SenderKeyDistributionMessage from `sender`.
+func (p *Party) ProcessSenderKey(groupID string, sender *Party, skdm []byte) error {
+	msg, e := protocol.NewSenderKeyDistributionMessageFromBytes(skdm)
+	if e != nil {
+		return e
+	}
+	gsb := groups.NewGroupSessionBuilder(p.Store)
+	return gsb.Process(p.senderKeyName(groupID, sender), msg)
+}
+
+// GroupEncrypt encrypts with our sender key, returns the signed `skmsg`.
+func (p *Party) GroupEncrypt(groupID string, plaintext []byte) ([]byte, error) {
+	gsb := groups.NewGroupSessionBuilder(p.Store)
+	gc := groups.NewGroupCipher(gsb, p.senderKeyName(groupID, p), p.Store)
+	msg, e := gc.Encrypt(plaintext)
+	if e != nil {
+		return nil, e
+	}
+	return msg.(*protocol.SenderKeyMessage).SignedSerialize(), nil
+}
+
+// GroupDecrypt decrypts the `skmsg` from `sender`.
+func (p *Party) GroupDecrypt(groupID string, sender *Party, skmsg []byte) ([]byte, error) {
+	msg, e := protocol.NewSenderKeyMessageFromBytes(skmsg)
+	if e != nil {
+		return nil, e
+	}
+	gsb := groups.NewGroupSessionBuilder(p.Store)
+	gc := groups.NewGroupCipher(gsb, p.senderKeyName(groupID, sender), p.Store)
+	return gc.Decrypt(msg)
+}
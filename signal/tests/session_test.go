@@ -0,0 +1,271 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"wa/signal/protocol"
+	"wa/signal/session"
+	"wa/signal/state/record"
+)
+
+type envelope struct {
+	data    []byte
+	msgType uint32
+	plain   []byte
+}
+
+func newPair(t *testing.T) (*Party, *Party) {
+	t.Helper()
+	alice, e := NewParty("alice", 1)
+	if e != nil {
+		t.Fatal(e)
+	}
+	bob, e := NewParty("bob", 1)
+	if e != nil {
+		t.Fatal(e)
+	}
+	return alice, bob
+}
+
+func send(t *testing.T, from, to *Party, plain string) *envelope {
+	t.Helper()
+	data, msgType, e := from.Encrypt(to, []byte(plain))
+	if e != nil {
+		t.Fatalf("%s encrypt: %v", from.Name, e)
+	}
+	return &envelope{data: data, msgType: msgType, plain: []byte(plain)}
+}
+
+func receive(t *testing.T, to, from *Party, env *envelope) {
+	t.Helper()
+	plain, e := to.Decrypt(from, env.data, env.msgType)
+	if e != nil {
+		t.Fatalf("%s decrypt: %v", to.Name, e)
+	}
+	if !bytes.Equal(plain, env.plain) {
+		t.Fatalf("%s decrypted %q, want %q", to.Name, plain, env.plain)
+	}
+}
+
+// alice fetches bob's bundle and sends the first message
+func establish(t *testing.T, alice, bob *Party) {
+	t.Helper()
+	if e := alice.StartSession(bob); e != nil {
+		t.Fatal(e)
+	}
+	env := send(t, alice, bob, "hello bob")
+	if env.msgType != protocol.PREKEY_TYPE {
+		t.Fatalf("first message type %d, want pkmsg", env.msgType)
+	}
+	receive(t, bob, alice, env)
+
+	reply := send(t, bob, alice, "hello alice")
+	if reply.msgType != protocol.WHISPER_TYPE {
+		t.Fatalf("reply type %d, want msg", reply.msgType)
+	}
+	receive(t, alice, bob, reply)
+}
+
+func TestPreKeyExchange(t *testing.T) {
+	alice, bob := newPair(t)
+
+	if e := alice.StartSession(bob); e != nil {
+		t.Fatal(e)
+	}
+
+	// all messages before bob replies are pkmsg
+	envs := []*envelope{}
+	for i := 0; i < 3; i++ {
+		env := send(t, alice, bob, fmt.Sprintf("pkmsg %d", i))
+		if env.msgType != protocol.PREKEY_TYPE {
+			t.Fatalf("message %d type %d, want pkmsg", i, env.msgType)
+		}
+		envs = append(envs, env)
+	}
+	for _, env := range envs {
+		receive(t, bob, alice, env)
+	}
+
+	// the one-time prekey is consumed
+	if bob.Store.ContainsPreKey(1) {
+		t.Fatal("one-time prekey not removed")
+	}
+
+	receive(t, alice, bob, send(t, bob, alice, "ack"))
+
+	// acknowledged, no more pkmsg
+	env := send(t, alice, bob, "after ack")
+	if env.msgType != protocol.WHISPER_TYPE {
+		t.Fatalf("type %d after ack, want msg", env.msgType)
+	}
+	receive(t, bob, alice, env)
+}
+
+func TestPingPong(t *testing.T) {
+	alice, bob := newPair(t)
+	establish(t, alice, bob)
+
+	for i := 0; i < 20; i++ {
+		receive(t, bob, alice, send(t, alice, bob, fmt.Sprintf("a->b %d", i)))
+		receive(t, alice, bob, send(t, bob, alice, fmt.Sprintf("b->a %d", i)))
+	}
+}
+
+func TestOutOfOrder(t *testing.T) {
+	alice, bob := newPair(t)
+	establish(t, alice, bob)
+
+	// two chains from alice, bob receives them backwards
+	var envs []*envelope
+	for i := 0; i < 10; i++ {
+		envs = append(envs, send(t, alice, bob, fmt.Sprintf("chain 1 - %d", i)))
+	}
+	receive(t, alice, bob, send(t, bob, alice, "ratchet"))
+	for i := 0; i < 10; i++ {
+		envs = append(envs, send(t, alice, bob, fmt.Sprintf("chain 2 - %d", i)))
+	}
+
+	for i := len(envs) - 1; i >= 0; i-- {
+		receive(t, bob, alice, envs[i])
+	}
+}
+
+func TestDuplicate(t *testing.T) {
+	alice, bob := newPair(t)
+	establish(t, alice, bob)
+
+	env := send(t, alice, bob, "once")
+	receive(t, bob, alice, env)
+
+	_, e := bob.Decrypt(alice, env.data, env.msgType)
+	var oldCounter *session.OldCounterError
+	if !errors.As(e, &oldCounter) {
+		t.Fatalf("duplicate: got %v, want OldCounterError", e)
+	}
+
+	// session still works
+	receive(t, bob, alice, send(t, alice, bob, "next"))
+}
+
+func TestForwardJumpLimit(t *testing.T) {
+	defer record.SetLimits(record.GetLimits())
+	limits := record.DefaultLimits
+	limits.MaxForwardJump = 5
+	if e := record.SetLimits(limits); e != nil {
+		t.Fatal(e)
+	}
+
+	alice, bob := newPair(t)
+	establish(t, alice, bob)
+
+	for i := 0; i < 10; i++ {
+		send(t, alice, bob, "lost")
+	}
+	env := send(t, alice, bob, "too far")
+
+	_, e := bob.Decrypt(alice, env.data, env.msgType)
+	var jump *session.ForwardJumpError
+	if !errors.As(e, &jump) {
+		t.Fatalf("got %v, want ForwardJumpError", e)
+	}
+	if jump.Max != 5 {
+		t.Fatalf("Max = %d, want 5", jump.Max)
+	}
+}
+
+func TestMessageKeysLimit(t *testing.T) {
+	defer record.SetLimits(record.GetLimits())
+	limits := record.DefaultLimits
+	limits.MaxMessageKeys = 3
+	if e := record.SetLimits(limits); e != nil {
+		t.Fatal(e)
+	}
+
+	alice, bob := newPair(t)
+	establish(t, alice, bob)
+
+	var envs []*envelope
+	for i := 0; i < 6; i++ {
+		envs = append(envs, send(t, alice, bob, fmt.Sprintf("skipped %d", i)))
+	}
+	// skips 0~4, only the last 3 keys are kept
+	receive(t, bob, alice, envs[5])
+	for _, env := range envs[2:5] {
+		receive(t, bob, alice, env)
+	}
+	_, e := bob.Decrypt(alice, envs[0].data, envs[0].msgType)
+	var oldCounter *session.OldCounterError
+	if !errors.As(e, &oldCounter) {
+		t.Fatalf("dropped key: got %v, want OldCounterError", e)
+	}
+}
+
+// bob loses the session and starts a new one, alice's old state is archived
+func TestSessionReset(t *testing.T) {
+	alice, bob := newPair(t)
+	establish(t, alice, bob)
+
+	bob.Store.DeleteSession(alice.Address)
+	if e := bob.StartSession(alice); e != nil {
+		t.Fatal(e)
+	}
+	env := send(t, bob, alice, "new session")
+	if env.msgType != protocol.PREKEY_TYPE {
+		t.Fatalf("type %d, want pkmsg", env.msgType)
+	}
+	receive(t, alice, bob, env)
+
+	rec, e := alice.Store.LoadSession(bob.Address)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if n := len(rec.PreviousSessionStates()); n != 1 {
+		t.Fatalf("archived states = %d, want 1", n)
+	}
+
+	receive(t, bob, alice, send(t, alice, bob, "on new session"))
+}
+
+// bob reinstalls, alice must not trust the new identity silently
+func TestIdentityChange(t *testing.T) {
+	alice, bob := newPair(t)
+	establish(t, alice, bob)
+
+	if e := bob.Reinstall(); e != nil {
+		t.Fatal(e)
+	}
+
+	if e := alice.StartSession(bob); e == nil {
+		t.Fatal("bundle with changed identity is trusted")
+	}
+
+	// bob starts a session with the new identity
+	if e := bob.StartSession(alice); e != nil {
+		t.Fatal(e)
+	}
+	env := send(t, bob, alice, "new identity")
+	if _, e := alice.Decrypt(bob, env.data, env.msgType); e == nil {
+		t.Fatal("pkmsg with changed identity is trusted")
+	}
+
+	// accept the new identity
+	if e := alice.Store.SaveIdentity(bob.Address, bob.IdentityKey()); e != nil {
+		t.Fatal(e)
+	}
+	receive(t, alice, bob, env)
+	receive(t, bob, alice, send(t, alice, bob, "welcome back"))
+}
+
+func TestLimitsValidate(t *testing.T) {
+	limits := record.DefaultLimits
+	limits.MaxMessageKeys = 0
+	if e := record.SetLimits(limits); e == nil {
+		t.Fatal("MaxMessageKeys = 0 accepted")
+	}
+	if record.GetLimits().MaxMessageKeys == 0 {
+		t.Fatal("invalid limits applied")
+	}
+}
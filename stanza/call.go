@@ -0,0 +1,62 @@
+package stanza
+
+import (
+	"ajson"
+	"wa/xmpp"
+)
+
+type Call struct {
+	base
+
+	Id   string
+	From string
+	T    int64
+
+	// tag of the first child:
+	// `offer`, `relaylatency`, `terminate`, `accept`, `reject`, ...
+	Action      string
+	CallId      string
+	CallCreator string
+}
+
+func DecodeCall(n *xmpp.Node) (*Call, error) {
+	a := newAttrs(n)
+
+	c := &Call{
+		base: base{node: n},
+		Id:   a.Required(`id`),
+		From: a.Required(`from`),
+		T:    a.Int64(`t`),
+	}
+	if e := a.Err(); e != nil {
+		return nil, e
+	}
+
+	if len(n.Children) > 0 {
+		ch0 := n.Children[0]
+		ca := ch0.MapAttrs()
+
+		c.Action = ch0.Tag
+		c.CallId = ca[`call-id`]
+		c.CallCreator = ca[`call-creator`]
+	}
+	return c, nil
+}
+
+func (c *Call) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`Tag`, c.Tag())
+	j.Set(`id`, c.Id)
+	j.Set(`from`, c.From)
+	j.Set(`t`, c.T)
+	j.Set(`action`, c.Action)
+	if c.CallId != `` {
+		j.Set(`call_id`, c.CallId)
+	}
+	if c.CallCreator != `` {
+		j.Set(`call_creator`, c.CallCreator)
+	}
+
+	set_legacy_json(j, c.node)
+	return j
+}
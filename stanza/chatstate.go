@@ -0,0 +1,55 @@
+package stanza
+
+import (
+	"ajson"
+	"wa/xmpp"
+
+	"github.com/pkg/errors"
+)
+
+type ChatState struct {
+	base
+
+	From        string
+	Participant string // group
+	State       string // `composing`/`paused`
+	Media       string // `audio` when recording voice
+}
+
+func DecodeChatState(n *xmpp.Node) (*ChatState, error) {
+	a := newAttrs(n)
+
+	c := &ChatState{
+		base:        base{node: n},
+		From:        a.Required(`from`),
+		Participant: a.Str(`participant`),
+	}
+	if e := a.Err(); e != nil {
+		return nil, e
+	}
+
+	if len(n.Children) == 0 {
+		return nil, errors.New(`chatstate: missing state`)
+	}
+	ch0 := n.Children[0]
+	c.State = ch0.Tag
+	c.Media, _ = ch0.GetAttr(`media`)
+
+	return c, nil
+}
+
+func (c *ChatState) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`Tag`, c.Tag())
+	j.Set(`from`, c.From)
+	if c.Participant != `` {
+		j.Set(`participant`, c.Participant)
+	}
+	j.Set(`state`, c.State)
+	if c.Media != `` {
+		j.Set(`media`, c.Media)
+	}
+
+	set_legacy_json(j, c.node)
+	return j
+}
@@ -0,0 +1,122 @@
+package stanza
+
+import (
+	"ahex"
+	"ajson"
+	"wa/xmpp"
+)
+
+// <dirty type="groups" timestamp="xx"/>
+type Dirty struct {
+	Type      string // `groups`/`account_sync`
+	Timestamp int64
+}
+
+func (d *Dirty) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`type`, d.Type)
+	j.Set(`timestamp`, d.Timestamp)
+	return j
+}
+
+// <safetynet>
+//
+//	<attestation key="" nonce=""/>
+//	<verify_apps count="10"/>
+//
+// </safetynet>
+type Safetynet struct {
+	Attestation bool
+	Key         string
+	Nonce       string
+
+	VerifyApps bool
+	Count      int
+}
+
+func (s *Safetynet) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`attestation`, s.Attestation)
+	j.Set(`verify_apps`, s.VerifyApps)
+	return j
+}
+
+type Ib struct {
+	base
+
+	From string
+
+	Dirty       *Dirty
+	RoutingInfo []byte // <edge_routing><routing_info>
+	Safetynet   *Safetynet
+	Offline     bool // <offline count="x"/>, offline messages all delivered
+}
+
+func DecodeIb(n *xmpp.Node) (*Ib, error) {
+	ib := &Ib{
+		base: base{node: n},
+	}
+	ib.From, _ = n.GetAttr(`from`)
+
+	for _, ch := range n.Children {
+		switch ch.Tag {
+		case `dirty`:
+			a := newAttrs(ch)
+			ib.Dirty = &Dirty{
+				Type:      a.Required(`type`),
+				Timestamp: a.Int64(`timestamp`),
+			}
+			if e := a.Err(); e != nil {
+				return nil, e
+			}
+		case `edge_routing`:
+			if ri, ok := ch.FindChildByTag(`routing_info`); ok {
+				ib.RoutingInfo = ri.Data
+			}
+		case `safetynet`:
+			sn := &Safetynet{}
+			for _, sch := range ch.Children {
+				a := newAttrs(sch)
+				switch sch.Tag {
+				case `attestation`:
+					sn.Attestation = true
+					sn.Key = a.Str(`key`)
+					sn.Nonce = a.Str(`nonce`)
+				case `verify_apps`:
+					sn.VerifyApps = true
+					sn.Count = a.Int(`count`)
+				}
+				if e := a.Err(); e != nil {
+					return nil, e
+				}
+			}
+			ib.Safetynet = sn
+		case `offline`:
+			ib.Offline = true
+		}
+	}
+	return ib, nil
+}
+
+func (ib *Ib) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`Tag`, ib.Tag())
+	if ib.From != `` {
+		j.Set(`from`, ib.From)
+	}
+	if ib.Dirty != nil {
+		j.Set(`dirty`, ib.Dirty.ToJson().Data())
+	}
+	if ib.RoutingInfo != nil {
+		j.Set(`routing_info`, ahex.Enc(ib.RoutingInfo))
+	}
+	if ib.Safetynet != nil {
+		j.Set(`safetynet`, ib.Safetynet.ToJson().Data())
+	}
+	if ib.Offline {
+		j.Set(`offline`, true)
+	}
+
+	set_legacy_json(j, ib.node)
+	return j
+}
@@ -0,0 +1,161 @@
+package stanza
+
+import (
+	"strings"
+
+	"ahex"
+	"ajson"
+	"wa/xmpp"
+)
+
+// decrypted content of a Message, filled by hooks
+type Content interface {
+	ToJson() *ajson.Json
+}
+
+// <enc type="pkmsg/msg/skmsg" v="2" mediatype="image">
+type Enc struct {
+	Type      string
+	V         string
+	MediaType string
+	Data      []byte
+}
+
+func (e *Enc) IsWhisper() bool {
+	return e.Type == `pkmsg` || e.Type == `msg`
+}
+func (e *Enc) IsSenderKey() bool {
+	return e.Type == `skmsg`
+}
+func (e *Enc) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`type`, e.Type)
+	j.Set(`v`, e.V)
+	if e.MediaType != `` {
+		j.Set(`mediatype`, e.MediaType)
+	}
+	return j
+}
+
+type Message struct {
+	base
+
+	Id          string
+	From        string // jid, or gid for group message
+	Participant string // sender jid of group message
+	Type        string // `text`/`media`
+	Notify      string // push name
+	T           int64
+
+	Enc []*Enc
+
+	// set after decrypted
+	MediaType string
+	Media     Content
+}
+
+func DecodeMessage(n *xmpp.Node) (*Message, error) {
+	a := newAttrs(n)
+
+	m := &Message{
+		base:        base{node: n},
+		Id:          a.Required(`id`),
+		From:        a.Required(`from`),
+		Participant: a.Str(`participant`),
+		Type:        a.Str(`type`),
+		Notify:      a.Str(`notify`),
+		T:           a.RequiredInt64(`t`),
+	}
+	if e := a.Err(); e != nil {
+		return nil, e
+	}
+
+	for _, ch := range n.Children {
+		if ch.Tag != `enc` {
+			continue
+		}
+		ca := ch.MapAttrs()
+		m.Enc = append(m.Enc, &Enc{
+			Type:      ca[`type`],
+			V:         ca[`v`],
+			MediaType: ca[`mediatype`],
+			Data:      ch.Data,
+		})
+	}
+	return m, nil
+}
+
+func (m *Message) IsGroup() bool {
+	return m.Participant != ``
+}
+func (m *Message) IsBroadcast() bool {
+	return strings.HasSuffix(m.From, `@broadcast`)
+}
+
+// the `pkmsg`/`msg` node
+func (m *Message) WhisperEnc() (*Enc, bool) {
+	for _, e := range m.Enc {
+		if e.IsWhisper() {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// the `skmsg` node
+func (m *Message) SenderKeyEnc() (*Enc, bool) {
+	for _, e := range m.Enc {
+		if e.IsSenderKey() {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+func (m *Message) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`Tag`, m.Tag())
+	j.Set(`id`, m.Id)
+	j.Set(`from`, m.From)
+	if m.Participant != `` {
+		j.Set(`participant`, m.Participant)
+	}
+	if m.Type != `` {
+		j.Set(`type`, m.Type)
+	}
+	if m.Notify != `` {
+		j.Set(`notify`, m.Notify)
+	}
+	j.Set(`t`, m.T)
+
+	if m.Media != nil {
+		j.Set(`media_type`, m.MediaType)
+		j.Set(`media`, m.Media.ToJson().Data())
+	} else {
+		// not decrypted, keep the cipher
+		enc := []any{}
+		for _, e := range m.Enc {
+			ej := e.ToJson()
+			ej.Set(`data`, ahex.Enc(e.Data))
+			enc = append(enc, ej.Data())
+		}
+		j.Set(`enc`, enc)
+	}
+
+	// old format: decrypted media in attrs `media`/`media_type`, without children
+	legacy := &xmpp.Node{
+		Tag:      m.node.Tag,
+		Attrs:    append([]*xmpp.KeyValue{}, m.node.Attrs...),
+		Children: m.node.Children,
+	}
+	if m.Media != nil {
+		legacy.Attrs = append(legacy.Attrs,
+			&xmpp.KeyValue{Key: `media`, Value: m.Media.ToJson().ToString()},
+			&xmpp.KeyValue{Key: `media_type`, Value: m.MediaType},
+		)
+		legacy.Children = nil
+	}
+	set_legacy_json(j, legacy)
+
+	return j
+}
@@ -0,0 +1,352 @@
+package stanza
+
+import (
+	"ajson"
+	"wa/xmpp"
+
+	"github.com/pkg/errors"
+)
+
+// type="devices"
+//
+//	<add device_hash="2:xx"><device jid="xx.0:4@s.whatsapp.net" key-index="1"/><key-index-list/></add>
+//	<remove device_hash="2:xx"><device jid="xx"/></remove>
+//	<update hash="xx"/>
+type Devices struct {
+	Action     string // `add`/`remove`/`update`
+	DeviceHash string
+	Jids       []string // device jids for add/remove
+}
+
+func (d *Devices) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`action`, d.Action)
+	if d.DeviceHash != `` {
+		j.Set(`hash`, d.DeviceHash)
+	}
+	j.Set(`jids`, d.Jids)
+	return j
+}
+
+// <group id="xx" creator="xx" subject="xx" creation="xx"><participant jid="xx" type="admin"/></group>
+type GroupInfo struct {
+	Id       string
+	Creator  string
+	Subject  string
+	Creation int64
+	Members  []string
+	Admins   []string // participants with type `admin` or `superadmin`
+}
+
+func (g *GroupInfo) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`id`, g.Id)
+	j.Set(`creator`, g.Creator)
+	j.Set(`subject`, g.Subject)
+	j.Set(`creation`, g.Creation)
+	j.Set(`members`, g.Members)
+	j.Set(`admins`, g.Admins)
+	return j
+}
+
+// type="w:gp2"
+type Group struct {
+	// `create`, `add`, `remove`, `promote`, `demote`, `subject`,
+	// `ephemeral`, `not_ephemeral`, ...
+	Action string
+
+	Participants []string   // add/remove/promote/demote
+	Subject      string     // subject
+	Ephemeral    int64      // ephemeral expiration
+	Info         *GroupInfo // create
+}
+
+func (g *Group) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`action`, g.Action)
+	if len(g.Participants) > 0 {
+		j.Set(`participants`, g.Participants)
+	}
+	if g.Subject != `` {
+		j.Set(`subject`, g.Subject)
+	}
+	if g.Ephemeral > 0 {
+		j.Set(`ephemeral`, g.Ephemeral)
+	}
+	if g.Info != nil {
+		j.Set(`group`, g.Info.ToJson().Data())
+	}
+	return j
+}
+
+// type="encrypt"
+//
+//	from server: <count value="5"/>, prekeys running low
+//	from peer:   <identity/>, peer re-registered
+type Encrypt struct {
+	PrekeyCount    int
+	HasCount       bool
+	IdentityChange bool
+}
+
+func (e *Encrypt) ToJson() *ajson.Json {
+	j := ajson.New()
+	if e.HasCount {
+		j.Set(`count`, e.PrekeyCount)
+	}
+	j.Set(`identity`, e.IdentityChange)
+	return j
+}
+
+// type="picture"
+//
+//	<set jid="xx" id="xx"/>
+//	<delete jid="xx"/>
+type Picture struct {
+	Action string // `set`/`delete`
+	Jid    string
+	Id     string
+}
+
+func (p *Picture) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`action`, p.Action)
+	j.Set(`jid`, p.Jid)
+	if p.Id != `` {
+		j.Set(`id`, p.Id)
+	}
+	return j
+}
+
+// type="status"
+//
+//	<set>new about</set>
+type Status struct {
+	Text string
+}
+
+func (s *Status) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`text`, s.Text)
+	return j
+}
+
+type Notification struct {
+	base
+
+	Id          string
+	From        string
+	Participant string
+	Type        string
+	T           int64
+
+	// only one of these is set, according to `Type`
+	Devices *Devices
+	Group   *Group
+	Encrypt *Encrypt
+	Picture *Picture
+	Status  *Status
+
+	// error of decoding the child, the notification is still
+	// returned with the typed field nil, so it can be acked
+	DecodeErr error
+}
+
+func DecodeNotification(n *xmpp.Node) (*Notification, error) {
+	a := newAttrs(n)
+
+	nt := &Notification{
+		base:        base{node: n},
+		Id:          a.Required(`id`),
+		From:        a.Required(`from`),
+		Participant: a.Str(`participant`),
+		Type:        a.Required(`type`),
+		T:           a.Int64(`t`),
+	}
+	if e := a.Err(); e != nil {
+		return nil, e
+	}
+
+	var e error
+	switch nt.Type {
+	case `devices`:
+		nt.Devices, e = decode_devices(n)
+	case `w:gp2`:
+		nt.Group, e = decode_group(n)
+	case `encrypt`:
+		nt.Encrypt, e = decode_encrypt(n)
+	case `picture`:
+		nt.Picture, e = decode_picture(n)
+	case `status`:
+		nt.Status = decode_status(n)
+	}
+	nt.DecodeErr = e
+	return nt, nil
+}
+
+func decode_devices(n *xmpp.Node) (*Devices, error) {
+	if len(n.Children) == 0 {
+		return nil, errors.New(`devices: no child`)
+	}
+	ch0 := n.Children[0]
+
+	d := &Devices{Action: ch0.Tag}
+	switch ch0.Tag {
+	case `add`, `remove`:
+		d.DeviceHash, _ = ch0.GetAttr(`device_hash`)
+		for _, ch := range ch0.Children {
+			if ch.Tag != `device` {
+				continue
+			}
+			jid, ok := ch.GetAttr(`jid`)
+			if !ok {
+				return nil, errors.New(`devices: no 'jid' attr`)
+			}
+			d.Jids = append(d.Jids, jid)
+		}
+	case `update`:
+		d.DeviceHash, _ = ch0.GetAttr(`hash`)
+	}
+	return d, nil
+}
+
+func participant_jids(n *xmpp.Node) []string {
+	jids := []string{}
+	for _, ch := range n.Children {
+		if ch.Tag != `participant` {
+			continue
+		}
+		if jid, ok := ch.GetAttr(`jid`); ok {
+			jids = append(jids, jid)
+		}
+	}
+	return jids
+}
+
+// <participant jid="xx" type="admin"/>
+func admin_jids(n *xmpp.Node) []string {
+	jids := []string{}
+	for _, ch := range n.Children {
+		if ch.Tag != `participant` {
+			continue
+		}
+		if t, _ := ch.GetAttr(`type`); t != `admin` && t != `superadmin` {
+			continue
+		}
+		if jid, ok := ch.GetAttr(`jid`); ok {
+			jids = append(jids, jid)
+		}
+	}
+	return jids
+}
+
+func decode_group(n *xmpp.Node) (*Group, error) {
+	if len(n.Children) == 0 {
+		return nil, errors.New(`w:gp2: no child`)
+	}
+	ch0 := n.Children[0]
+
+	g := &Group{Action: ch0.Tag}
+
+	switch ch0.Tag {
+	case `create`:
+		chGroup, ok := ch0.FindChildByTag(`group`)
+		if !ok {
+			return nil, errors.New(`w:gp2: 'create' without 'group'`)
+		}
+		a := newAttrs(chGroup)
+		g.Info = &GroupInfo{
+			Id:       a.Str(`id`),
+			Creator:  a.Required(`creator`),
+			Subject:  a.Required(`subject`),
+			Creation: a.Int64(`creation`),
+			Members:  participant_jids(chGroup),
+			Admins:   admin_jids(chGroup),
+		}
+		if e := a.Err(); e != nil {
+			return nil, e
+		}
+	case `add`, `remove`, `promote`, `demote`:
+		g.Participants = participant_jids(ch0)
+	case `subject`:
+		g.Subject, _ = ch0.GetAttr(`subject`)
+	case `ephemeral`:
+		a := newAttrs(ch0)
+		g.Ephemeral = a.Int64(`expiration`)
+		if e := a.Err(); e != nil {
+			return nil, e
+		}
+	}
+	return g, nil
+}
+
+func decode_encrypt(n *xmpp.Node) (*Encrypt, error) {
+	enc := &Encrypt{}
+	if ch, ok := n.FindChildByTag(`count`); ok {
+		enc.HasCount = true
+		a := newAttrs(ch)
+		enc.PrekeyCount = a.Int(`value`)
+		if e := a.Err(); e != nil {
+			return nil, e
+		}
+	}
+	if _, ok := n.FindChildByTag(`identity`); ok {
+		enc.IdentityChange = true
+	}
+	return enc, nil
+}
+
+func decode_picture(n *xmpp.Node) (*Picture, error) {
+	if len(n.Children) == 0 {
+		return nil, errors.New(`picture: no child`)
+	}
+	ch0 := n.Children[0]
+	a := ch0.MapAttrs()
+
+	return &Picture{
+		Action: ch0.Tag,
+		Jid:    a[`jid`],
+		Id:     a[`id`],
+	}, nil
+}
+
+func decode_status(n *xmpp.Node) *Status {
+	s := &Status{}
+	if ch, ok := n.FindChildByTag(`set`); ok {
+		s.Text = string(ch.Data)
+	}
+	return s
+}
+
+func (nt *Notification) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`Tag`, nt.Tag())
+	j.Set(`id`, nt.Id)
+	j.Set(`from`, nt.From)
+	if nt.Participant != `` {
+		j.Set(`participant`, nt.Participant)
+	}
+	j.Set(`type`, nt.Type)
+	j.Set(`t`, nt.T)
+	if nt.DecodeErr != nil {
+		j.Set(`decode_error`, nt.DecodeErr.Error())
+	}
+
+	switch {
+	case nt.Devices != nil:
+		j.Set(`devices`, nt.Devices.ToJson().Data())
+	case nt.Group != nil:
+		j.Set(`group`, nt.Group.ToJson().Data())
+	case nt.Encrypt != nil:
+		j.Set(`encrypt`, nt.Encrypt.ToJson().Data())
+	case nt.Picture != nil:
+		j.Set(`picture`, nt.Picture.ToJson().Data())
+	case nt.Status != nil:
+		j.Set(`status`, nt.Status.ToJson().Data())
+	default: // unknown type, push whole node
+		j.Set(`node`, nt.node.ToJson().Data())
+	}
+
+	set_legacy_json(j, nt.node)
+	return j
+}
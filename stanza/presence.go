@@ -0,0 +1,48 @@
+package stanza
+
+import (
+	"ajson"
+	"wa/xmpp"
+)
+
+type Presence struct {
+	base
+
+	From string
+	Type string // `available`/`unavailable`
+	Last int64  // last seen, 0 if hidden
+}
+
+func DecodePresence(n *xmpp.Node) (*Presence, error) {
+	a := newAttrs(n)
+
+	p := &Presence{
+		base: base{node: n},
+		From: a.Required(`from`),
+		Type: a.Str(`type`),
+	}
+	// `last` can be "deny"/"none"
+	if last := a.Str(`last`); last != `deny` && last != `none` {
+		p.Last = a.Int64(`last`)
+	}
+	if p.Type == `` {
+		p.Type = `available`
+	}
+	if e := a.Err(); e != nil {
+		return nil, e
+	}
+	return p, nil
+}
+
+func (p *Presence) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`Tag`, p.Tag())
+	j.Set(`from`, p.From)
+	j.Set(`type`, p.Type)
+	if p.Last > 0 {
+		j.Set(`last`, p.Last)
+	}
+
+	set_legacy_json(j, p.node)
+	return j
+}
@@ -0,0 +1,118 @@
+package stanza
+
+import (
+	"ajson"
+	"wa/xmpp"
+)
+
+// <retry count="1" id="xxx" t="123" v="1"/>
+type RetryInfo struct {
+	Count int
+	Id    string
+	T     int64
+	V     string
+
+	Registration []byte     // <registration>
+	Keys         *xmpp.Node // <keys>, when peer sends its prekey bundle
+}
+
+func (r *RetryInfo) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`count`, r.Count)
+	j.Set(`id`, r.Id)
+	j.Set(`t`, r.T)
+	j.Set(`v`, r.V)
+	return j
+}
+
+type Receipt struct {
+	base
+
+	Id          string
+	From        string
+	Participant string // group receipt
+	Recipient   string // receipt of the message sent by own companion device
+	Type        string // ``(delivered), `read`, `retry`, `played`, ...
+	T           int64
+
+	// more message ids in <list><item id="xx"/></list>
+	List []string
+
+	Retry *RetryInfo // only for Type == `retry`
+}
+
+func DecodeReceipt(n *xmpp.Node) (*Receipt, error) {
+	a := newAttrs(n)
+
+	r := &Receipt{
+		base:        base{node: n},
+		Id:          a.Required(`id`),
+		From:        a.Required(`from`),
+		Participant: a.Str(`participant`),
+		Recipient:   a.Str(`recipient`),
+		Type:        a.Str(`type`),
+		T:           a.Int64(`t`),
+	}
+	if e := a.Err(); e != nil {
+		return nil, e
+	}
+
+	if list, ok := n.FindChildByTag(`list`); ok {
+		for _, item := range list.Children {
+			if id, ok := item.GetAttr(`id`); ok {
+				r.List = append(r.List, id)
+			}
+		}
+	}
+
+	if ch, ok := n.FindChildByTag(`retry`); ok {
+		ra := newAttrs(ch)
+		r.Retry = &RetryInfo{
+			Count: ra.Int(`count`),
+			Id:    ra.Str(`id`),
+			T:     ra.Int64(`t`),
+			V:     ra.Str(`v`),
+		}
+		if e := ra.Err(); e != nil {
+			return nil, e
+		}
+		if reg, ok := n.FindChildByTag(`registration`); ok {
+			r.Retry.Registration = reg.Data
+		}
+		if keys, ok := n.FindChildByTag(`keys`); ok {
+			r.Retry.Keys = keys
+		}
+	}
+	return r, nil
+}
+
+// Id + List
+func (r *Receipt) Ids() []string {
+	return append([]string{r.Id}, r.List...)
+}
+
+func (r *Receipt) ToJson() *ajson.Json {
+	j := ajson.New()
+	j.Set(`Tag`, r.Tag())
+	j.Set(`id`, r.Id)
+	j.Set(`from`, r.From)
+	if r.Participant != `` {
+		j.Set(`participant`, r.Participant)
+	}
+	if r.Recipient != `` {
+		j.Set(`recipient`, r.Recipient)
+	}
+	if r.Type != `` {
+		j.Set(`type`, r.Type)
+	}
+	j.Set(`t`, r.T)
+	if len(r.List) > 0 {
+		j.Set(`list`, r.List)
+	}
+	if r.Retry != nil {
+		j.Set(`retry`, r.Retry.ToJson().Data())
+	}
+
+	set_legacy_json(j, r.node)
+	return j
+}
@@ -0,0 +1,125 @@
+// Package stanza decodes incoming xmpp nodes into typed structs,
+// so hooks don't need to parse attributes/children by themselves.
+//
+// Pushed json of a stanza has the typed keys (`id`, `from`, `media`...),
+// together with `Attrs`/`Children`/`Data` of the old format (node.ToJson()),
+// those are kept for compatibility with existing clients, see set_legacy_json.
+package stanza
+
+import (
+	"strconv"
+
+	"ajson"
+	"wa/xmpp"
+
+	"github.com/pkg/errors"
+)
+
+type Stanza interface {
+	Tag() string
+	Node() *xmpp.Node // the original node
+	ToJson() *ajson.Json
+}
+
+// Decode node to typed Stanza,
+// tags that are not handled here are returned as *Raw
+func Decode(n *xmpp.Node) (Stanza, error) {
+	switch n.Tag {
+	case `message`:
+		return DecodeMessage(n)
+	case `receipt`:
+		return DecodeReceipt(n)
+	case `notification`:
+		return DecodeNotification(n)
+	case `call`:
+		return DecodeCall(n)
+	case `presence`:
+		return DecodePresence(n)
+	case `chatstate`:
+		return DecodeChatState(n)
+	case `ib`:
+		return DecodeIb(n)
+	}
+	return NewRaw(n), nil
+}
+
+type base struct {
+	node *xmpp.Node
+}
+
+func (b *base) Tag() string {
+	return b.node.Tag
+}
+func (b *base) Node() *xmpp.Node {
+	return b.node
+}
+
+// Raw is a node without typed decoder, eg: `iq`
+type Raw struct {
+	base
+}
+
+func NewRaw(n *xmpp.Node) *Raw {
+	return &Raw{base{node: n}}
+}
+func (r *Raw) ToJson() *ajson.Json {
+	return r.node.ToJson()
+}
+
+// Keys of the old push format, the whole node as json:
+//
+//	{Tag, Attrs: {...}, Children: [...], Data: "hex"}
+//
+// kept for existing clients, new clients should use the typed keys.
+func set_legacy_json(j *ajson.Json, n *xmpp.Node) {
+	nj := n.ToJson()
+	for _, key := range []string{`Attrs`, `Children`, `Data`} {
+		if v, ok := nj.TryGet(key); ok {
+			j.Set(key, v.Data())
+		}
+	}
+}
+
+// `attrs` wraps node attributes for required/optional lookup
+type attrs struct {
+	tag string
+	m   map[string]string
+	e   error // first error
+}
+
+func newAttrs(n *xmpp.Node) *attrs {
+	return &attrs{tag: n.Tag, m: n.MapAttrs()}
+}
+func (a *attrs) Str(key string) string {
+	return a.m[key]
+}
+
+// must exist and not empty
+func (a *attrs) Required(key string) string {
+	v, ok := a.m[key]
+	if (!ok || v == ``) && a.e == nil {
+		a.e = errors.New(a.tag + `: missing attr '` + key + `'`)
+	}
+	return v
+}
+func (a *attrs) Int64(key string) int64 {
+	v, ok := a.m[key]
+	if !ok {
+		return 0
+	}
+	i, e := strconv.ParseInt(v, 10, 64)
+	if e != nil && a.e == nil {
+		a.e = errors.Wrap(e, a.tag+`: wrong attr '`+key+`'`)
+	}
+	return i
+}
+func (a *attrs) RequiredInt64(key string) int64 {
+	a.Required(key)
+	return a.Int64(key)
+}
+func (a *attrs) Int(key string) int {
+	return int(a.Int64(key))
+}
+func (a *attrs) Err() error {
+	return a.e
+}